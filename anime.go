@@ -1,10 +1,12 @@
 package gojikan
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -38,15 +40,194 @@ type AiredDate struct {
 	Year  int `json:"year"`
 }
 
+// Relation is a type of how an anime or manga is related to the anime
+type Relation string
+
+// Relations spelled like Jikan's keys
+const (
+	RelationAdaptation         Relation = "Adaptation"
+	RelationSideStory          Relation = "Side story"
+	RelationSummary            Relation = "Summary"
+	RelationSequel             Relation = "Sequel"
+	RelationPrequel            Relation = "Prequel"
+	RelationAlternativeVersion Relation = "Alternative version"
+	RelationAlternativeSetting Relation = "Alternative setting"
+	RelationSpinOff            Relation = "Spin-off"
+	RelationParentStory        Relation = "Parent story"
+	RelationFullStory          Relation = "Full story"
+	RelationCharacter          Relation = "Character"
+	RelationOther              Relation = "Other"
+)
+
+// knownRelations are the relations modelled by RelatedAnime in the order returned by All
+var knownRelations = []Relation{
+	RelationAdaptation,
+	RelationSideStory,
+	RelationSummary,
+	RelationSequel,
+	RelationPrequel,
+	RelationAlternativeVersion,
+	RelationAlternativeSetting,
+	RelationSpinOff,
+	RelationParentStory,
+	RelationFullStory,
+	RelationCharacter,
+	RelationOther,
+}
+
 // RelatedAnime is a struct of other anime related to this anime
+// Relations that are not known yet are kept in Unknown by their Jikan's key
 type RelatedAnime struct {
-	Adaptation []AnimeResource `json:"Adaptation"`
-	SideStory  []AnimeResource `json:"Side story"`
-	Summary    []AnimeResource `json:"Summary"`
+	Adaptation         []AnimeResource
+	SideStory          []AnimeResource
+	Summary            []AnimeResource
+	Sequel             []AnimeResource
+	Prequel            []AnimeResource
+	AlternativeVersion []AnimeResource
+	AlternativeSetting []AnimeResource
+	SpinOff            []AnimeResource
+	ParentStory        []AnimeResource
+	FullStory          []AnimeResource
+	Character          []AnimeResource
+	Other              []AnimeResource
+	Unknown            map[string][]AnimeResource
+}
+
+// relation return the field of the relation, matching the key regardless of its case,
+// spaces, and dashes, or nil if the relation is not known
+func (ths *RelatedAnime) relation(key string) *[]AnimeResource {
+	normalized := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(key))
+
+	switch normalized {
+	case "adaptation":
+		return &ths.Adaptation
+	case "sidestory":
+		return &ths.SideStory
+	case "summary":
+		return &ths.Summary
+	case "sequel":
+		return &ths.Sequel
+	case "prequel":
+		return &ths.Prequel
+	case "alternativeversion":
+		return &ths.AlternativeVersion
+	case "alternativesetting":
+		return &ths.AlternativeSetting
+	case "spinoff":
+		return &ths.SpinOff
+	case "parentstory":
+		return &ths.ParentStory
+	case "fullstory":
+		return &ths.FullStory
+	case "character":
+		return &ths.Character
+	case "other":
+		return &ths.Other
+	}
+
+	return nil
+}
+
+// Add appends the resources to the relation with the key
+func (ths *RelatedAnime) Add(key string, resources ...AnimeResource) {
+	if field := ths.relation(key); field != nil {
+		*field = append(*field, resources...)
+		return
+	}
+
+	if ths.Unknown == nil {
+		ths.Unknown = make(map[string][]AnimeResource)
+	}
+
+	ths.Unknown[key] = append(ths.Unknown[key], resources...)
+}
+
+// Sequels return the sequels of the anime
+func (ths RelatedAnime) Sequels() []AnimeResource {
+	return ths.Sequel
+}
+
+// Prequels return the prequels of the anime
+func (ths RelatedAnime) Prequels() []AnimeResource {
+	return ths.Prequel
+}
+
+// Relations return every non-empty relation, including the unknown ones
+func (ths RelatedAnime) Relations() map[Relation][]AnimeResource {
+	relations := make(map[Relation][]AnimeResource)
+	ths.each(func(relation Relation, resources []AnimeResource) {
+		relations[relation] = resources
+	})
+
+	return relations
+}
+
+// All return the resources of every relation, the known relations first
+// and then the unknown ones sorted by their key
+func (ths RelatedAnime) All() (resources []AnimeResource) {
+	ths.each(func(_ Relation, relationResources []AnimeResource) {
+		resources = append(resources, relationResources...)
+	})
+
+	return
+}
+
+// each calls fn with every non-empty relation in the same order as All
+func (ths *RelatedAnime) each(fn func(relation Relation, resources []AnimeResource)) {
+	for _, relation := range knownRelations {
+		if resources := *ths.relation(string(relation)); len(resources) > 0 {
+			fn(relation, resources)
+		}
+	}
+
+	keys := make([]string, 0, len(ths.Unknown))
+	for key := range ths.Unknown {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if resources := ths.Unknown[key]; len(resources) > 0 {
+			fn(Relation(key), resources)
+		}
+	}
+}
+
+// UnmarshalJSON decodes Jikan's object of relation keys, or an empty array when there is no relation
+func (ths *RelatedAnime) UnmarshalJSON(data []byte) error {
+	*ths = RelatedAnime{}
+
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte("[]")) {
+		return nil
+	}
+
+	var relations map[string][]AnimeResource
+	if err := json.Unmarshal(data, &relations); err != nil {
+		return err
+	}
+
+	for key, resources := range relations {
+		ths.Add(key, resources...)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the relations with Jikan's keys
+func (ths RelatedAnime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ths.Relations())
 }
 
 // Anime is a struct of anime details from MyAnimeList
 type Anime struct {
+	ResponseMeta
+
 	MalID         int             `json:"mal_id"`
 	URL           string          `json:"url"`
 	ImageURL      string          `json:"image_url"`
@@ -55,14 +236,14 @@ type Anime struct {
 	TitleEnglish  string          `json:"title_english"`
 	TitleJapanese string          `json:"title_japanese"`
 	TitleSynonyms []string        `json:"title_synonyms"`
-	Type          string          `json:"type"`
-	Source        string          `json:"source"`
+	Type          AnimeType       `json:"type"`
+	Source        AnimeSource     `json:"source"`
 	Episodes      int             `json:"episodes"`
-	Status        string          `json:"status"`
+	Status        AnimeStatus     `json:"status"`
 	Airing        bool            `json:"airing"`
 	Aired         AiredTimeline   `json:"aired"`
 	Duration      string          `json:"duration"`
-	Rating        string          `json:"rating"`
+	Rating        Rating          `json:"rating"`
 	Score         float64         `json:"score"`
 	ScoredBy      int             `json:"scored_by"`
 	Rank          int             `json:"rank"`
@@ -82,28 +263,13 @@ type Anime struct {
 }
 
 func (ths *jikanClient) GetAnime(id int) (anime Anime, err error) {
-	url := fmt.Sprintf("%s/anime/%d", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &anime)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeContext(ctx context.Context, id int) (anime Anime, err error) {
+	url := fmt.Sprintf("%s/anime/%d", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &anime)
 	return
 }
 
@@ -139,33 +305,20 @@ type AnimeStaff struct {
 
 // AnimeCharacterStaff is a struct of characters and staffs of the anime
 type AnimeCharacterStaff struct {
+	ResponseMeta
+
 	Characters []AnimeCharacter `json:"characters"`
 	Staff      []AnimeStaff     `json:"staff"`
 }
 
 func (ths *jikanClient) GetAnimeCharacterStaff(id int) (animeCharStaff AnimeCharacterStaff, err error) {
-	url := fmt.Sprintf("%s/anime/%d/characters_staff", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeCharacterStaffContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animeCharStaff)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeCharacterStaffContext(ctx context.Context, id int) (animeCharStaff AnimeCharacterStaff, err error) {
+	url := fmt.Sprintf("%s/anime/%d/characters_staff", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animeCharStaff)
 	return
 }
 
@@ -174,6 +327,8 @@ func (ths *jikanClient) GetAnimeCharacterStaff(id int) (animeCharStaff AnimeChar
 // AnimeEpisodes is a struct of all episodes in the anime with pagination
 // One page consist of max 100 episodes
 type AnimeEpisodes struct {
+	ResponseMeta
+
 	EpisodesLastPage int            `json:"episodes_last_page"`
 	Episodes         []AnimeEpisode `json:"episodes"`
 }
@@ -195,31 +350,16 @@ type AnimeEpisode struct {
 // Maximum 100 episode per page, if there are more you have to call next page
 // Put 0 in page parameter if don't want to use the page
 func (ths *jikanClient) GetAnimeAllEpisodes(id, page int) (animeEpisodes AnimeEpisodes, err error) {
+	return ths.GetAnimeAllEpisodesContext(context.Background(), id, page)
+}
+
+func (ths *jikanClient) GetAnimeAllEpisodesContext(ctx context.Context, id, page int) (animeEpisodes AnimeEpisodes, err error) {
 	url := fmt.Sprintf("%s/anime/%d/episodes", ths.baseURL, id)
 	if page > 0 {
 		url = fmt.Sprintf("%s/%d", url, page)
 	}
 
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
-
-	err = json.Unmarshal(body, &animeEpisodes)
-	if err != nil {
-		return
-	}
-
+	err = ths.get(ctx, url, &animeEpisodes)
 	return
 }
 
@@ -227,6 +367,8 @@ func (ths *jikanClient) GetAnimeAllEpisodes(id, page int) (animeEpisodes AnimeEp
 
 // AnimeNews is a struct of related news articles of the anime
 type AnimeNews struct {
+	ResponseMeta
+
 	Articles []AnimeNewsArticle `json:"articles"`
 }
 
@@ -244,28 +386,13 @@ type AnimeNewsArticle struct {
 }
 
 func (ths *jikanClient) GetAnimeRelatedNews(id int) (animeNews AnimeNews, err error) {
-	url := fmt.Sprintf("%s/anime/%d/news", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeRelatedNewsContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animeNews)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeRelatedNewsContext(ctx context.Context, id int) (animeNews AnimeNews, err error) {
+	url := fmt.Sprintf("%s/anime/%d/news", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animeNews)
 	return
 }
 
@@ -273,6 +400,8 @@ func (ths *jikanClient) GetAnimeRelatedNews(id int) (animeNews AnimeNews, err er
 
 // AnimePictures is a struct of related pictures of the anime
 type AnimePictures struct {
+	ResponseMeta
+
 	Pictures []AnimePicture `json:"pictures"`
 }
 
@@ -283,28 +412,13 @@ type AnimePicture struct {
 }
 
 func (ths *jikanClient) GetAnimeRelatedPictures(id int) (animePictures AnimePictures, err error) {
-	url := fmt.Sprintf("%s/anime/%d/pictures", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeRelatedPicturesContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animePictures)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeRelatedPicturesContext(ctx context.Context, id int) (animePictures AnimePictures, err error) {
+	url := fmt.Sprintf("%s/anime/%d/pictures", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animePictures)
 	return
 }
 
@@ -312,11 +426,10 @@ func (ths *jikanClient) GetAnimeRelatedPictures(id int) (animePictures AnimePict
 
 // AnimeVideos is a struct of related promotional and episodes videos of the anime
 type AnimeVideos struct {
-	RequestHash        string              `json:"request_hash"`
-	RequestCached      bool                `json:"request_cached"`
-	RequestCacheExpiry int                 `json:"request_cache_expiry"`
-	Promo              []AnimeVideoPromo   `json:"promo"`
-	Episodes           []AnimeVideoEpisode `json:"episodes"`
+	ResponseMeta
+
+	Promo    []AnimeVideoPromo   `json:"promo"`
+	Episodes []AnimeVideoEpisode `json:"episodes"`
 }
 
 // AnimeVideoPromo is a struct of details of related promotional video of the anime
@@ -335,28 +448,13 @@ type AnimeVideoEpisode struct {
 }
 
 func (ths *jikanClient) GetAnimeRelatedVideos(id int) (animeVideos AnimeVideos, err error) {
-	url := fmt.Sprintf("%s/anime/%d/videos", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeRelatedVideosContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animeVideos)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeRelatedVideosContext(ctx context.Context, id int) (animeVideos AnimeVideos, err error) {
+	url := fmt.Sprintf("%s/anime/%d/videos", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animeVideos)
 	return
 }
 
@@ -364,6 +462,8 @@ func (ths *jikanClient) GetAnimeRelatedVideos(id int) (animeVideos AnimeVideos,
 
 // AnimeStats is a struct of related stats of the anime
 type AnimeStats struct {
+	ResponseMeta
+
 	Watching    int         `json:"watching"`
 	Completed   int         `json:"completed"`
 	OnHold      int         `json:"on_hold"`
@@ -394,28 +494,13 @@ type AnimeScores struct {
 }
 
 func (ths *jikanClient) GetAnimeRelatedStats(id int) (animeStats AnimeStats, err error) {
-	url := fmt.Sprintf("%s/anime/%d/stats", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeRelatedStatsContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animeStats)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeRelatedStatsContext(ctx context.Context, id int) (animeStats AnimeStats, err error) {
+	url := fmt.Sprintf("%s/anime/%d/stats", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animeStats)
 	return
 }
 
@@ -423,6 +508,8 @@ func (ths *jikanClient) GetAnimeRelatedStats(id int) (animeStats AnimeStats, err
 
 // AnimeForum is a struct of related forum topics of the anime
 type AnimeForum struct {
+	ResponseMeta
+
 	Topics []AnimeForumTopic `json:"topics"`
 }
 
@@ -447,28 +534,13 @@ type AnimeForumTopic struct {
 }
 
 func (ths *jikanClient) GetAnimeRelatedForum(id int) (animeForum AnimeForum, err error) {
-	url := fmt.Sprintf("%s/anime/%d/stats", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeRelatedForumContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animeForum)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeRelatedForumContext(ctx context.Context, id int) (animeForum AnimeForum, err error) {
+	url := fmt.Sprintf("%s/anime/%d/stats", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animeForum)
 	return
 }
 
@@ -476,6 +548,8 @@ func (ths *jikanClient) GetAnimeRelatedForum(id int) (animeForum AnimeForum, err
 
 // AnimeRecommendations is a struct list of recommendations for the related anime
 type AnimeRecommendations struct {
+	ResponseMeta
+
 	Recommendations []AnimeRecommendation `json:"recommendations"`
 }
 
@@ -490,28 +564,13 @@ type AnimeRecommendation struct {
 }
 
 func (ths *jikanClient) GetAnimeRecommendations(id int) (animeRecommendations AnimeRecommendations, err error) {
-	url := fmt.Sprintf("%s/anime/%d/recommendations", ths.baseURL, id)
-
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
+	return ths.GetAnimeRecommendationsContext(context.Background(), id)
+}
 
-	err = json.Unmarshal(body, &animeRecommendations)
-	if err != nil {
-		return
-	}
+func (ths *jikanClient) GetAnimeRecommendationsContext(ctx context.Context, id int) (animeRecommendations AnimeRecommendations, err error) {
+	url := fmt.Sprintf("%s/anime/%d/recommendations", ths.baseURL, id)
 
+	err = ths.get(ctx, url, &animeRecommendations)
 	return
 }
 
@@ -519,6 +578,8 @@ func (ths *jikanClient) GetAnimeRecommendations(id int) (animeRecommendations An
 
 // AnimeReviews is a struct list of anime reviews by user
 type AnimeReviews struct {
+	ResponseMeta
+
 	Reviews []AnimeReview `json:"reviews"`
 }
 
@@ -553,30 +614,15 @@ type AnimeReview struct {
 }
 
 func (ths *jikanClient) GetAnimeReviews(id, page int) (animeReviews AnimeReviews, err error) {
+	return ths.GetAnimeReviewsContext(context.Background(), id, page)
+}
+
+func (ths *jikanClient) GetAnimeReviewsContext(ctx context.Context, id, page int) (animeReviews AnimeReviews, err error) {
 	url := fmt.Sprintf("%s/anime/%d/reviews", ths.baseURL, id)
 	if page > 0 {
 		url = fmt.Sprintf("%s/%d", url, page)
 	}
 
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	resp, err := ths.client.Do(req)
-	if err != nil {
-		return
-	}
-
-	err = ths.checkStatusError(resp.StatusCode)
-	if err != nil {
-		return
-	}
-
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
-
-	err = json.Unmarshal(body, &animeReviews)
-	if err != nil {
-		return
-	}
-
+	err = ths.get(ctx, url, &animeReviews)
 	return
 }
@@ -1099,3 +1099,82 @@ func TestAnimeEndpoints(t *testing.T) {
 		})
 	})
 }
+
+func TestRelatedAnime(t *testing.T) {
+	Convey("Testing RelatedAnime", t, func() {
+		Convey("RelatedAnime should decode every relation and keep unknown ones", func() {
+			var related RelatedAnime
+			err := json.Unmarshal([]byte(`{
+				"Adaptation": [{"mal_id": 173, "type": "manga", "name": "Cowboy Bebop"}],
+				"Side story": [{"mal_id": 5, "type": "anime", "name": "Cowboy Bebop: Tengoku no Tobira"}],
+				"Summary": [{"mal_id": 4037, "type": "anime"}],
+				"Sequel": [{"mal_id": 2, "type": "anime"}],
+				"Prequel": [{"mal_id": 3, "type": "anime"}],
+				"Alternative version": [{"mal_id": 4, "type": "anime"}],
+				"Alternative setting": [{"mal_id": 6, "type": "anime"}],
+				"Spin-off": [{"mal_id": 7, "type": "anime"}],
+				"Parent story": [{"mal_id": 8, "type": "anime"}],
+				"Full story": [{"mal_id": 9, "type": "anime"}],
+				"Character": [{"mal_id": 10, "type": "anime"}],
+				"Other": [{"mal_id": 11, "type": "anime"}],
+				"Crossover": [{"mal_id": 12, "type": "anime"}]
+			}`), &related)
+
+			So(err, ShouldBeNil)
+			So(related.Adaptation[0].MalID, ShouldEqual, 173)
+			So(related.SideStory[0].MalID, ShouldEqual, 5)
+			So(related.Summary[0].MalID, ShouldEqual, 4037)
+			So(related.AlternativeVersion[0].MalID, ShouldEqual, 4)
+			So(related.AlternativeSetting[0].MalID, ShouldEqual, 6)
+			So(related.SpinOff[0].MalID, ShouldEqual, 7)
+			So(related.ParentStory[0].MalID, ShouldEqual, 8)
+			So(related.FullStory[0].MalID, ShouldEqual, 9)
+			So(related.Character[0].MalID, ShouldEqual, 10)
+			So(related.Other[0].MalID, ShouldEqual, 11)
+			So(related.Unknown["Crossover"][0].MalID, ShouldEqual, 12)
+			So(related.Sequels(), ShouldResemble, []AnimeResource{AnimeResource{MalID: 2, Type: "anime"}})
+			So(related.Prequels(), ShouldResemble, []AnimeResource{AnimeResource{MalID: 3, Type: "anime"}})
+
+			var ids []int
+			for _, resource := range related.All() {
+				ids = append(ids, resource.MalID)
+			}
+			So(ids, ShouldResemble, []int{173, 5, 4037, 2, 3, 4, 6, 7, 8, 9, 10, 11, 12})
+			So(related.Relations(), ShouldHaveLength, 13)
+			So(related.Relations()[RelationSpinOff][0].MalID, ShouldEqual, 7)
+		})
+
+		Convey("RelatedAnime should decode an empty array as no relation", func() {
+			var related RelatedAnime
+			err := json.Unmarshal([]byte(`[]`), &related)
+
+			So(err, ShouldBeNil)
+			So(related.All(), ShouldBeEmpty)
+		})
+
+		Convey("RelatedAnime should match relation keys regardless of their spelling", func() {
+			var related RelatedAnime
+			related.Add("Spin-Off", AnimeResource{MalID: 1})
+			related.Add("spin off", AnimeResource{MalID: 2})
+			related.Add("Alternative Version", AnimeResource{MalID: 3})
+
+			So(related.SpinOff, ShouldHaveLength, 2)
+			So(related.AlternativeVersion, ShouldHaveLength, 1)
+			So(related.Unknown, ShouldBeNil)
+		})
+
+		Convey("RelatedAnime should encode back to Jikan's keys", func() {
+			related := RelatedAnime{
+				Sequel:  []AnimeResource{AnimeResource{MalID: 2}},
+				Unknown: map[string][]AnimeResource{"Crossover": []AnimeResource{AnimeResource{MalID: 12}}},
+			}
+
+			data, err := json.Marshal(related)
+			So(err, ShouldBeNil)
+
+			var decoded RelatedAnime
+			So(json.Unmarshal(data, &decoded), ShouldBeNil)
+			So(decoded, ShouldResemble, related)
+		})
+	})
+}
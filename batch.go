@@ -0,0 +1,92 @@
+package gojikan
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultBatchConcurrency is the number of workers of a batch when it is not set
+const defaultBatchConcurrency = 4
+
+// BatchOptions is a struct of options for batch fetches
+// Concurrency is the number of requests sent at the same time, it defaults to 4
+// The requests still go through the client's rate limiter
+type BatchOptions struct {
+	Concurrency int
+}
+
+func (ths *BatchOptions) concurrency() int {
+	if ths == nil || ths.Concurrency <= 0 {
+		return defaultBatchConcurrency
+	}
+
+	return ths.Concurrency
+}
+
+// AnimeBatchResult is a struct of the anime or the error of fetching it
+type AnimeBatchResult struct {
+	ID    int
+	Anime Anime
+	Err   error
+}
+
+// GetAnimeBatch return the anime of every id in the same order as ids
+// Repeated ids are only fetched once and a failing id does not stop the others,
+// err is only returned when the context is done before every id is fetched
+func (ths *jikanClient) GetAnimeBatch(ctx context.Context, ids []int, opts *BatchOptions) (results []AnimeBatchResult, err error) {
+	return getAnimeBatch(ctx, ths, ids, opts)
+}
+
+func getAnimeBatch(ctx context.Context, client ContextClient, ids []int, opts *BatchOptions) (results []AnimeBatchResult, err error) {
+	positions := make(map[int][]int)
+	var unique []int
+	for i, id := range ids {
+		if _, ok := positions[id]; !ok {
+			unique = append(unique, id)
+		}
+
+		positions[id] = append(positions[id], i)
+	}
+
+	results = make([]AnimeBatchResult, len(ids))
+	jobs := make(chan int)
+
+	workers := opts.concurrency()
+	if workers > len(unique) {
+		workers = len(unique)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for id := range jobs {
+				result := AnimeBatchResult{ID: id}
+				result.Anime, result.Err = client.GetAnimeContext(ctx, id)
+
+				for _, position := range positions[id] {
+					results[position] = result
+				}
+			}
+		}()
+	}
+
+	for _, id := range unique {
+		if ctx.Err() != nil {
+			for _, position := range positions[id] {
+				results[position] = AnimeBatchResult{ID: id, Err: ctx.Err()}
+			}
+			continue
+		}
+
+		jobs <- id
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	err = ctx.Err()
+	return
+}
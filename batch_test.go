@@ -0,0 +1,111 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetAnimeBatch(t *testing.T) {
+	Convey("Testing GetAnimeBatch Method", t, func() {
+		var mu sync.Mutex
+		var inFlight, maxInFlight int
+		requested := map[string]int{}
+
+		jikan := NewJikanClient(WithHTTPClient(&MockClient{
+			MockDo: func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				requested[req.URL.Path]++
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+
+				if strings.HasSuffix(req.URL.Path, "/404") {
+					return &http.Response{
+						StatusCode: 404,
+						Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+					}, nil
+				}
+
+				id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(fmt.Sprintf(`{"mal_id":%s}`, id)))),
+				}, nil
+			},
+		}))
+
+		Convey("GetAnimeBatch should return results and errors in input order", func() {
+			results, err := jikan.GetAnimeBatch(context.Background(), []int{3, 404, 1, 2}, nil)
+
+			So(err, ShouldBeNil)
+			So(results, ShouldHaveLength, 4)
+			So(results[0].ID, ShouldEqual, 3)
+			So(results[0].Anime.MalID, ShouldEqual, 3)
+			So(results[0].Err, ShouldBeNil)
+			So(results[1].ID, ShouldEqual, 404)
+			So(errors.Is(results[1].Err, ErrNotFound), ShouldBeTrue)
+			So(results[2].Anime.MalID, ShouldEqual, 1)
+			So(results[3].Anime.MalID, ShouldEqual, 2)
+		})
+
+		Convey("GetAnimeBatch should fetch repeated ids once", func() {
+			results, err := jikan.GetAnimeBatch(context.Background(), []int{1, 2, 1, 1}, nil)
+
+			So(err, ShouldBeNil)
+			So(results[0], ShouldResemble, results[2])
+			So(results[0], ShouldResemble, results[3])
+			So(requested["/v3/anime/1"], ShouldEqual, 1)
+			So(requested["/v3/anime/2"], ShouldEqual, 1)
+		})
+
+		Convey("GetAnimeBatch should not send more requests at once than the concurrency", func() {
+			ids := make([]int, 20)
+			for i := range ids {
+				ids[i] = i + 1
+			}
+
+			results, err := jikan.GetAnimeBatch(context.Background(), ids, &BatchOptions{Concurrency: 3})
+
+			So(err, ShouldBeNil)
+			So(results, ShouldHaveLength, 20)
+			So(maxInFlight, ShouldBeBetweenOrEqual, 1, 3)
+			So(len(requested), ShouldEqual, 20)
+		})
+
+		Convey("GetAnimeBatch should return the context error when it is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			results, err := jikan.GetAnimeBatch(ctx, []int{1, 2}, nil)
+
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			So(errors.Is(results[0].Err, context.Canceled), ShouldBeTrue)
+			So(errors.Is(results[1].Err, context.Canceled), ShouldBeTrue)
+		})
+
+		Convey("GetAnimeBatch should return empty results for empty ids", func() {
+			results, err := jikan.GetAnimeBatch(context.Background(), nil, nil)
+
+			So(err, ShouldBeNil)
+			So(results, ShouldBeEmpty)
+		})
+	})
+}
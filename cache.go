@@ -0,0 +1,312 @@
+package gojikan
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache is an interface for storing response bodies by their request URL
+// Implementations must be safe to use across goroutines
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache return a context that makes the request skip the cached response
+// The fresh response is still stored in the cache
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// cacheTTL return how long the response can be cached, taken from Jikan's request_cache_expiry
+// in the body or else from the Expires header
+func cacheTTL(resp *http.Response, body []byte, now time.Time) time.Duration {
+	var meta ResponseMeta
+	if json.Unmarshal(body, &meta) == nil && meta.RequestCacheExpiry > 0 {
+		return time.Duration(meta.RequestCacheExpiry) * time.Second
+	}
+
+	expires, err := http.ParseTime(resp.Header.Get("Expires"))
+	if err != nil {
+		return 0
+	}
+
+	return expires.Sub(now)
+}
+
+// defaultRevalidationWindow is how long an expired response with ETag or Last-Modified
+// is kept in the cache to be revalidated
+const defaultRevalidationWindow = 24 * time.Hour
+
+// cachedResponse is a response body stored in the cache with its validators
+type cachedResponse struct {
+	Body         []byte    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Expires      time.Time `json:"expires"`
+}
+
+func (ths *cachedResponse) fresh(now time.Time) bool {
+	return now.Before(ths.Expires)
+}
+
+// conditionalHeader return the headers to revalidate the cached response
+func (ths *cachedResponse) conditionalHeader() http.Header {
+	if ths == nil {
+		return nil
+	}
+
+	header := http.Header{}
+	if ths.ETag != "" {
+		header.Set("If-None-Match", ths.ETag)
+	}
+
+	if ths.LastModified != "" {
+		header.Set("If-Modified-Since", ths.LastModified)
+	}
+
+	return header
+}
+
+// cachedResponse return the cached response of the url, even if it has expired
+func (ths *jikanClient) cachedResponse(url string) *cachedResponse {
+	data, ok := ths.cache.Get(url)
+	if !ok {
+		return nil
+	}
+
+	var cached cachedResponse
+	if json.Unmarshal(data, &cached) != nil {
+		return nil
+	}
+
+	return &cached
+}
+
+// storeResponse stores the response body of the url for the ttl,
+// responses with ETag or Last-Modified are kept longer to be revalidated
+// The validators of the previous response are kept when the response does not have them
+func (ths *jikanClient) storeResponse(url string, resp *http.Response, body []byte, ttl time.Duration, previous *cachedResponse) {
+	if ths.cache == nil {
+		return
+	}
+
+	if ttl < 0 {
+		ttl = 0
+	}
+
+	cached := cachedResponse{
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Expires:      time.Now().Add(ttl),
+	}
+
+	if previous != nil {
+		if cached.ETag == "" {
+			cached.ETag = previous.ETag
+		}
+
+		if cached.LastModified == "" {
+			cached.LastModified = previous.LastModified
+		}
+	}
+
+	keep := ttl
+	if cached.ETag != "" || cached.LastModified != "" {
+		keep += ths.revalidate
+	}
+
+	if keep <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	ths.cache.Set(url, data, keep)
+}
+
+// ===================================================================================================================================
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entry when it is full
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+// NewMemoryCache return a MemoryCache that holds up to capacity entries
+// Put zero or less capacity to hold unlimited entries
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get return the value of the key if it exists and has not expired
+func (ths *MemoryCache) Get(key string) ([]byte, bool) {
+	ths.mu.Lock()
+	defer ths.mu.Unlock()
+
+	element, ok := ths.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*memoryCacheEntry)
+	if !ths.now().Before(entry.expires) {
+		ths.remove(element)
+		return nil, false
+	}
+
+	ths.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set stores the value of the key for the ttl
+func (ths *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	ths.mu.Lock()
+	defer ths.mu.Unlock()
+
+	entry := &memoryCacheEntry{
+		key:     key,
+		value:   value,
+		expires: ths.now().Add(ttl),
+	}
+
+	if element, ok := ths.entries[key]; ok {
+		element.Value = entry
+		ths.order.MoveToFront(element)
+		return
+	}
+
+	ths.entries[key] = ths.order.PushFront(entry)
+	if ths.capacity > 0 && ths.order.Len() > ths.capacity {
+		ths.remove(ths.order.Back())
+	}
+}
+
+// Len return the number of entries in the cache, including the expired ones
+func (ths *MemoryCache) Len() int {
+	ths.mu.Lock()
+	defer ths.mu.Unlock()
+
+	return ths.order.Len()
+}
+
+func (ths *MemoryCache) remove(element *list.Element) {
+	ths.order.Remove(element)
+	delete(ths.entries, element.Value.(*memoryCacheEntry).key)
+}
+
+// ===================================================================================================================================
+
+type fileCacheEntry struct {
+	Expires time.Time `json:"expires"`
+	Value   []byte    `json:"value"`
+}
+
+// FileCache is a Cache that stores every entry as a file in a directory
+// Failing to read or write the files is treated as a cache miss
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache return a FileCache that stores its entries in dir
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{
+		dir: dir,
+		now: time.Now,
+	}
+}
+
+// Get return the value of the key if it exists and has not expired
+func (ths *FileCache) Get(key string) ([]byte, bool) {
+	path := ths.path(key)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if json.Unmarshal(data, &entry) != nil || !ths.now().Before(entry.Expires) {
+		os.Remove(path)
+		return nil, false
+	}
+
+	return entry.Value, true
+}
+
+// Set stores the value of the key for the ttl
+func (ths *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(fileCacheEntry{
+		Expires: ths.now().Add(ttl),
+		Value:   value,
+	})
+	if err != nil {
+		return
+	}
+
+	if os.MkdirAll(ths.dir, 0755) != nil {
+		return
+	}
+
+	file, err := ioutil.TempFile(ths.dir, ".tmp-")
+	if err != nil {
+		return
+	}
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil || os.Rename(file.Name(), ths.path(key)) != nil {
+		os.Remove(file.Name())
+	}
+}
+
+func (ths *FileCache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(ths.dir, hex.EncodeToString(hash[:])+".json")
+}
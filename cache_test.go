@@ -0,0 +1,217 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCache(t *testing.T) {
+	Convey("Testing Cache", t, func() {
+		now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		clock := func() time.Time { return now }
+
+		Convey("MemoryCache should expire entries after the ttl", func() {
+			cache := NewMemoryCache(0)
+			cache.now = clock
+
+			cache.Set("a", []byte("1"), time.Minute)
+			cache.Set("b", []byte("2"), 0)
+
+			value, ok := cache.Get("a")
+			So(ok, ShouldBeTrue)
+			So(string(value), ShouldEqual, "1")
+
+			_, ok = cache.Get("b")
+			So(ok, ShouldBeFalse)
+
+			now = now.Add(time.Minute)
+			_, ok = cache.Get("a")
+			So(ok, ShouldBeFalse)
+			So(cache.Len(), ShouldEqual, 0)
+		})
+
+		Convey("MemoryCache should evict the least recently used entry when it is full", func() {
+			cache := NewMemoryCache(2)
+
+			cache.Set("a", []byte("1"), time.Minute)
+			cache.Set("b", []byte("2"), time.Minute)
+			cache.Get("a")
+			cache.Set("c", []byte("3"), time.Minute)
+
+			_, ok := cache.Get("b")
+			So(ok, ShouldBeFalse)
+
+			_, ok = cache.Get("a")
+			So(ok, ShouldBeTrue)
+
+			cache.Set("a", []byte("4"), time.Minute)
+			value, _ := cache.Get("a")
+			So(string(value), ShouldEqual, "4")
+			So(cache.Len(), ShouldEqual, 2)
+		})
+
+		Convey("FileCache should store entries as files until they expire", func() {
+			dir, err := ioutil.TempDir("", "gojikan")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(dir)
+
+			cache := NewFileCache(dir)
+			cache.now = clock
+
+			cache.Set("https://api.jikan.moe/v3/anime/1", []byte(`{"mal_id":1}`), time.Minute)
+
+			reopened := NewFileCache(dir)
+			reopened.now = clock
+
+			value, ok := reopened.Get("https://api.jikan.moe/v3/anime/1")
+			So(ok, ShouldBeTrue)
+			So(string(value), ShouldEqual, `{"mal_id":1}`)
+
+			_, ok = cache.Get("https://api.jikan.moe/v3/anime/2")
+			So(ok, ShouldBeFalse)
+
+			now = now.Add(time.Minute)
+			_, ok = cache.Get("https://api.jikan.moe/v3/anime/1")
+			So(ok, ShouldBeFalse)
+
+			files, _ := ioutil.ReadDir(dir)
+			So(files, ShouldBeEmpty)
+		})
+
+		Convey("cacheTTL should use request_cache_expiry before the Expires header", func() {
+			resp := &http.Response{Header: http.Header{"Expires": []string{"Wed, 01 Jan 2020 01:00:00 GMT"}}}
+
+			So(cacheTTL(resp, []byte(`{"request_cache_expiry":60}`), now), ShouldEqual, time.Minute)
+			So(cacheTTL(resp, []byte(`{"data":{}}`), now), ShouldEqual, time.Hour)
+			So(cacheTTL(&http.Response{}, []byte(`{}`), now), ShouldEqual, 0)
+		})
+
+		Convey("Client with cache should reuse the cached response until it is bypassed", func() {
+			calls := 0
+			jikan := NewJikanClient(
+				WithCache(NewMemoryCache(10)),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						calls++
+						return &http.Response{
+							StatusCode: 200,
+							Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"request_cache_expiry":60,"mal_id":1}`))),
+						}, nil
+					},
+				}),
+			)
+
+			anime, err := jikan.GetAnime(1)
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+
+			anime, err = jikan.GetAnime(1)
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+			So(calls, ShouldEqual, 1)
+
+			_, err = jikan.GetAnimeContext(BypassCache(context.Background()), 1)
+			So(err, ShouldBeNil)
+			So(calls, ShouldEqual, 2)
+
+			_, err = jikan.GetAnime(2)
+			So(err, ShouldBeNil)
+			So(calls, ShouldEqual, 3)
+		})
+
+		Convey("Client with cache should not cache response without expiry", func() {
+			calls := 0
+			jikan := NewJikanClient(
+				WithCache(NewMemoryCache(10)),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						calls++
+						return &http.Response{
+							StatusCode: 200,
+							Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"mal_id":1}`))),
+						}, nil
+					},
+				}),
+			)
+
+			jikan.GetAnime(1)
+			jikan.GetAnime(1)
+
+			So(calls, ShouldEqual, 2)
+		})
+
+		Convey("Client with cache should revalidate expired response with ETag and Last-Modified", func() {
+			var requests []*http.Request
+			jikan := NewJikanClient(
+				WithCache(NewMemoryCache(10)),
+				WithHTTPClient(&MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						requests = append(requests, req)
+						if len(requests) == 1 {
+							return &http.Response{
+								StatusCode: 200,
+								Header: http.Header{
+									"Etag":          []string{`"abc"`},
+									"Last-Modified": []string{"Wed, 01 Jan 2020 00:00:00 GMT"},
+								},
+								Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"mal_id":1}`))),
+							}, nil
+						}
+
+						return &http.Response{
+							StatusCode: 304,
+							Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+						}, nil
+					},
+				}),
+			)
+
+			anime, err := jikan.GetAnime(1)
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+			So(requests[0].Header.Get("If-None-Match"), ShouldBeEmpty)
+
+			anime, err = jikan.GetAnime(1)
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+			So(requests, ShouldHaveLength, 2)
+			So(requests[1].Header.Get("If-None-Match"), ShouldEqual, `"abc"`)
+			So(requests[1].Header.Get("If-Modified-Since"), ShouldEqual, "Wed, 01 Jan 2020 00:00:00 GMT")
+
+			anime, err = jikan.GetAnime(1)
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+			So(requests[2].Header.Get("If-None-Match"), ShouldEqual, `"abc"`)
+
+			jikan.GetAnimeContext(BypassCache(context.Background()), 1)
+			So(requests[3].Header.Get("If-None-Match"), ShouldBeEmpty)
+		})
+
+		Convey("Client with cache should not keep expired response without validators", func() {
+			cache := NewMemoryCache(10)
+			jikan := NewJikanClient(
+				WithCache(cache),
+				WithRevalidationWindow(time.Hour),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"mal_id":1}`))),
+						}, nil
+					},
+				}),
+			)
+
+			jikan.GetAnime(1)
+
+			So(cache.Len(), ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,63 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+)
+
+// CharacterAppearance is a struct of anime or manga where the character appears
+type CharacterAppearance struct {
+	MalID    int    `json:"mal_id"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	ImageURL string `json:"image_url"`
+	Role     string `json:"role"`
+}
+
+// Character is a struct of character details from MyAnimeList
+type Character struct {
+	ResponseMeta
+
+	MalID           int                   `json:"mal_id"`
+	URL             string                `json:"url"`
+	Name            string                `json:"name"`
+	NameKanji       string                `json:"name_kanji"`
+	Nicknames       []string              `json:"nicknames"`
+	About           string                `json:"about"`
+	MemberFavorites int                   `json:"member_favorites"`
+	ImageURL        string                `json:"image_url"`
+	Animeography    []CharacterAppearance `json:"animeography"`
+	Mangaography    []CharacterAppearance `json:"mangaography"`
+	VoiceActors     []AnimeVoiceActor     `json:"voice_actors"`
+}
+
+func (ths *jikanClient) GetCharacter(id int) (character Character, err error) {
+	return ths.GetCharacterContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetCharacterContext(ctx context.Context, id int) (character Character, err error) {
+	url := fmt.Sprintf("%s/character/%d", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &character)
+	return
+}
+
+// ===================================================================================================================================
+
+// CharacterPictures is a struct of related pictures of the character
+type CharacterPictures struct {
+	ResponseMeta
+
+	Pictures []AnimePicture `json:"pictures"`
+}
+
+func (ths *jikanClient) GetCharacterPictures(id int) (characterPictures CharacterPictures, err error) {
+	return ths.GetCharacterPicturesContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetCharacterPicturesContext(ctx context.Context, id int) (characterPictures CharacterPictures, err error) {
+	url := fmt.Sprintf("%s/character/%d/pictures", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &characterPictures)
+	return
+}
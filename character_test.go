@@ -0,0 +1,204 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCharacterEndpoints(t *testing.T) {
+	Convey("Testing Character Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+		characterID := 1
+
+		Convey("Testing GetCharacter Method", func() {
+			expectedCharacter := Character{
+				MalID:           characterID,
+				URL:             "https://myanimelist.net/character/1/Spike_Spiegel",
+				Name:            "Spike Spiegel",
+				NameKanji:       "スパイク・スピーゲル",
+				Nicknames:       []string{"Swimming Bird"},
+				MemberFavorites: 40000,
+				ImageURL:        "https://cdn.myanimelist.net/images/characters/4/50197.jpg",
+				Animeography: []CharacterAppearance{
+					CharacterAppearance{
+						MalID:    1,
+						Name:     "Cowboy Bebop",
+						URL:      "https://myanimelist.net/anime/1/Cowboy_Bebop",
+						ImageURL: "https://cdn.myanimelist.net/images/anime/4/19644.jpg",
+						Role:     "Main",
+					},
+				},
+				VoiceActors: []AnimeVoiceActor{
+					AnimeVoiceActor{
+						MalID:    11,
+						Name:     "Yamadera, Kouichi",
+						URL:      "https://myanimelist.net/people/11/Kouichi_Yamadera",
+						ImageURL: "https://cdn.myanimelist.net/images/voiceactors/1/54600.jpg",
+						Language: "Japanese",
+					},
+				},
+			}
+
+			expectedCharacterBytes, err := json.Marshal(expectedCharacter)
+			So(err, ShouldBeNil)
+
+			Convey("GetCharacter should return a Character given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedCharacterBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				character, err := jikan.GetCharacter(characterID)
+
+				So(character, ShouldResemble, expectedCharacter)
+				So(character.MalID, ShouldEqual, characterID)
+				So(character.Animeography[0].Role, ShouldEqual, "Main")
+				So(character.VoiceActors[0].Language, ShouldEqual, "Japanese")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetCharacter should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				character, err := jikan.GetCharacter(characterID)
+
+				So(character, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetCharacter should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				character, err := jikan.GetCharacter(0)
+
+				So(character, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetCharacter should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				character, err := jikan.GetCharacter(0)
+
+				So(character, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetCharacterPictures Method", func() {
+			expectedCharacterPictures := CharacterPictures{
+				Pictures: []AnimePicture{
+					AnimePicture{
+						Large: "https://cdn.myanimelist.net/images/characters/4/50197l.jpg",
+						Small: "https://cdn.myanimelist.net/images/characters/4/50197.jpg",
+					},
+				},
+			}
+
+			expectedCharacterPicturesBytes, err := json.Marshal(expectedCharacterPictures)
+			So(err, ShouldBeNil)
+
+			Convey("GetCharacterPictures should return a CharacterPictures given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedCharacterPicturesBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				characterPictures, err := jikan.GetCharacterPictures(characterID)
+
+				So(characterPictures, ShouldResemble, expectedCharacterPictures)
+				So(len(characterPictures.Pictures), ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetCharacterPictures should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				characterPictures, err := jikan.GetCharacterPictures(characterID)
+
+				So(characterPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetCharacterPictures should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				characterPictures, err := jikan.GetCharacterPictures(0)
+
+				So(characterPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetCharacterPictures should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				characterPictures, err := jikan.GetCharacterPictures(0)
+
+				So(characterPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
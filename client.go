@@ -1,10 +1,18 @@
 package gojikan
 
-import "net/http"
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
 
 // Client is an interface for the Jikan client and responsible
 // for all API calls to Jikan API
 type Client interface {
+	ContextClient
+
 	GetAnime(id int) (anime Anime, err error)
 	GetAnimeCharacterStaff(id int) (animeCharStaff AnimeCharacterStaff, err error)
 	GetAnimeAllEpisodes(id, page int) (animeEpisodes AnimeEpisodes, err error)
@@ -15,6 +23,126 @@ type Client interface {
 	GetAnimeRelatedForum(id int) (animeForum AnimeForum, err error)
 	GetAnimeRecommendations(id int) (animeRecommendations AnimeRecommendations, err error)
 	GetAnimeReviews(id, page int) (animeReviews AnimeReviews, err error)
+
+	GetManga(id int) (manga Manga, err error)
+	GetMangaCharacters(id int) (mangaCharacters MangaCharacters, err error)
+	GetMangaNews(id int) (mangaNews MangaNews, err error)
+	GetMangaPictures(id int) (mangaPictures MangaPictures, err error)
+	GetMangaStats(id int) (mangaStats MangaStats, err error)
+	GetMangaForum(id int) (mangaForum MangaForum, err error)
+	GetMangaMoreInfo(id int) (mangaMoreInfo MangaMoreInfo, err error)
+	GetMangaRecommendations(id int) (mangaRecommendations MangaRecommendations, err error)
+	GetMangaReviews(id, page int) (mangaReviews MangaReviews, err error)
+	GetMangaUserUpdates(id, page int) (mangaUserUpdates MangaUserUpdates, err error)
+
+	GetCharacter(id int) (character Character, err error)
+	GetCharacterPictures(id int) (characterPictures CharacterPictures, err error)
+
+	GetPerson(id int) (person Person, err error)
+	GetPersonPictures(id int) (personPictures PersonPictures, err error)
+
+	SearchAnime(query *SearchQuery) (animeSearch AnimeSearch, err error)
+	SearchManga(query *SearchQuery) (mangaSearch MangaSearch, err error)
+	SearchPeople(query *SearchQuery) (peopleSearch PeopleSearch, err error)
+	SearchCharacters(query *SearchQuery) (characterSearch CharacterSearch, err error)
+
+	GetSeason(year int, season Season) (animeSeason AnimeSeason, err error)
+	GetSeasonArchive() (seasonArchive SeasonArchive, err error)
+	GetSeasonLater() (animeSeason AnimeSeason, err error)
+
+	GetSchedule(day Weekday) (schedule Schedule, err error)
+
+	GetTopAnime(page int, subtype TopAnimeSubtype) (topAnime TopAnime, err error)
+	GetTopManga(page int, subtype TopMangaSubtype) (topManga TopManga, err error)
+	GetTopPeople(page int) (topPeople TopPeople, err error)
+	GetTopCharacters(page int) (topCharacters TopCharacters, err error)
+
+	GetAnimeByGenre(genreID, page int) (animeGenre AnimeGenre, err error)
+	GetMangaByGenre(genreID, page int) (mangaGenre MangaGenre, err error)
+	GetAnimeByProducer(producerID, page int) (animeProducer AnimeProducer, err error)
+	GetMangaByMagazine(magazineID, page int) (mangaMagazine MangaMagazine, err error)
+	ResolveResource(resource AnimeResource, page int) (listing ResourceListing, err error)
+
+	GetUserProfile(username string) (userProfile UserProfile, err error)
+	GetUserHistory(username string, kind HistoryKind) (userHistory UserHistory, err error)
+	GetUserFriends(username string, page int) (userFriends UserFriends, err error)
+	GetUserAnimeList(username string, filter ListStatus, page int, opts *UserListOptions) (userAnimeList UserAnimeList, err error)
+	GetUserMangaList(username string, filter ListStatus, page int, opts *UserListOptions) (userMangaList UserMangaList, err error)
+
+	GetClub(id int) (club Club, err error)
+	GetClubMembers(id, page int) (clubMembers ClubMembers, err error)
+
+	GetMetaStatus() (metaStatus MetaStatus, err error)
+	GetMetaRequests(requestType string, period MetaPeriod, offset int) (metaRequests MetaRequests, err error)
+}
+
+// ContextClient is an interface for the Jikan client calls that accept a context
+// The context cancels the request and its deadline is propagated to the HTTP request
+type ContextClient interface {
+	GetAnimeContext(ctx context.Context, id int) (anime Anime, err error)
+	GetAnimeCharacterStaffContext(ctx context.Context, id int) (animeCharStaff AnimeCharacterStaff, err error)
+	GetAnimeAllEpisodesContext(ctx context.Context, id, page int) (animeEpisodes AnimeEpisodes, err error)
+	GetAnimeRelatedNewsContext(ctx context.Context, id int) (animeNews AnimeNews, err error)
+	GetAnimeRelatedPicturesContext(ctx context.Context, id int) (animePictures AnimePictures, err error)
+	GetAnimeRelatedVideosContext(ctx context.Context, id int) (animeVideos AnimeVideos, err error)
+	GetAnimeRelatedStatsContext(ctx context.Context, id int) (animeStats AnimeStats, err error)
+	GetAnimeRelatedForumContext(ctx context.Context, id int) (animeForum AnimeForum, err error)
+	GetAnimeRecommendationsContext(ctx context.Context, id int) (animeRecommendations AnimeRecommendations, err error)
+	GetAnimeReviewsContext(ctx context.Context, id, page int) (animeReviews AnimeReviews, err error)
+	GetAnimeBatch(ctx context.Context, ids []int, opts *BatchOptions) (results []AnimeBatchResult, err error)
+	GetAnimeFull(ctx context.Context, id int, include ...Part) (animeFull AnimeFull, err error)
+	BuildFranchise(ctx context.Context, rootID int, opts *FranchiseOptions) (franchise Franchise, err error)
+
+	GetMangaContext(ctx context.Context, id int) (manga Manga, err error)
+	GetMangaCharactersContext(ctx context.Context, id int) (mangaCharacters MangaCharacters, err error)
+	GetMangaNewsContext(ctx context.Context, id int) (mangaNews MangaNews, err error)
+	GetMangaPicturesContext(ctx context.Context, id int) (mangaPictures MangaPictures, err error)
+	GetMangaStatsContext(ctx context.Context, id int) (mangaStats MangaStats, err error)
+	GetMangaForumContext(ctx context.Context, id int) (mangaForum MangaForum, err error)
+	GetMangaMoreInfoContext(ctx context.Context, id int) (mangaMoreInfo MangaMoreInfo, err error)
+	GetMangaRecommendationsContext(ctx context.Context, id int) (mangaRecommendations MangaRecommendations, err error)
+	GetMangaReviewsContext(ctx context.Context, id, page int) (mangaReviews MangaReviews, err error)
+	GetMangaUserUpdatesContext(ctx context.Context, id, page int) (mangaUserUpdates MangaUserUpdates, err error)
+
+	GetCharacterContext(ctx context.Context, id int) (character Character, err error)
+	GetCharacterPicturesContext(ctx context.Context, id int) (characterPictures CharacterPictures, err error)
+
+	GetPersonContext(ctx context.Context, id int) (person Person, err error)
+	GetPersonPicturesContext(ctx context.Context, id int) (personPictures PersonPictures, err error)
+
+	SearchAnimeContext(ctx context.Context, query *SearchQuery) (animeSearch AnimeSearch, err error)
+	SearchMangaContext(ctx context.Context, query *SearchQuery) (mangaSearch MangaSearch, err error)
+	SearchPeopleContext(ctx context.Context, query *SearchQuery) (peopleSearch PeopleSearch, err error)
+	SearchCharactersContext(ctx context.Context, query *SearchQuery) (characterSearch CharacterSearch, err error)
+
+	GetSeasonContext(ctx context.Context, year int, season Season) (animeSeason AnimeSeason, err error)
+	GetSeasonArchiveContext(ctx context.Context) (seasonArchive SeasonArchive, err error)
+	GetSeasonLaterContext(ctx context.Context) (animeSeason AnimeSeason, err error)
+
+	GetScheduleContext(ctx context.Context, day Weekday) (schedule Schedule, err error)
+
+	GetTopAnimeContext(ctx context.Context, page int, subtype TopAnimeSubtype) (topAnime TopAnime, err error)
+	GetTopMangaContext(ctx context.Context, page int, subtype TopMangaSubtype) (topManga TopManga, err error)
+	GetTopPeopleContext(ctx context.Context, page int) (topPeople TopPeople, err error)
+	GetTopCharactersContext(ctx context.Context, page int) (topCharacters TopCharacters, err error)
+
+	GetAnimeByGenreContext(ctx context.Context, genreID, page int) (animeGenre AnimeGenre, err error)
+	GetMangaByGenreContext(ctx context.Context, genreID, page int) (mangaGenre MangaGenre, err error)
+	GetAnimeByProducerContext(ctx context.Context, producerID, page int) (animeProducer AnimeProducer, err error)
+	GetMangaByMagazineContext(ctx context.Context, magazineID, page int) (mangaMagazine MangaMagazine, err error)
+	ResolveResourceContext(ctx context.Context, resource AnimeResource, page int) (listing ResourceListing, err error)
+
+	GetUserProfileContext(ctx context.Context, username string) (userProfile UserProfile, err error)
+	GetUserHistoryContext(ctx context.Context, username string, kind HistoryKind) (userHistory UserHistory, err error)
+	GetUserFriendsContext(ctx context.Context, username string, page int) (userFriends UserFriends, err error)
+	GetUserAnimeListContext(ctx context.Context, username string, filter ListStatus, page int, opts *UserListOptions) (userAnimeList UserAnimeList, err error)
+	GetUserMangaListContext(ctx context.Context, username string, filter ListStatus, page int, opts *UserListOptions) (userMangaList UserMangaList, err error)
+
+	GetClubContext(ctx context.Context, id int) (club Club, err error)
+	GetClubMembersContext(ctx context.Context, id, page int) (clubMembers ClubMembers, err error)
+
+	GetMetaStatusContext(ctx context.Context) (metaStatus MetaStatus, err error)
+	GetMetaRequestsContext(ctx context.Context, requestType string, period MetaPeriod, offset int) (metaRequests MetaRequests, err error)
 }
 
 // HTTPClient is an interface for mocking http library calls
@@ -22,15 +150,155 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+const (
+	jikanV3BaseURL = "https://api.jikan.moe/v3"
+	jikanV4BaseURL = "https://api.jikan.moe/v4"
+)
+
 type jikanClient struct {
-	baseURL string
-	client  HTTPClient
+	baseURL    string
+	v3BaseURL  string
+	client     HTTPClient
+	userAgent  string
+	timeout    time.Duration
+	headers    http.Header
+	limiter    *RateLimiter
+	retry      *RetryPolicy
+	cache      Cache
+	revalidate time.Duration
 }
 
 // NewJikanClient will return jikanClient that implements Client interface
-func NewJikanClient() Client {
-	return &jikanClient{
-		baseURL: "https://api.jikan.moe/v3",
-		client:  &http.Client{},
+// Without any option it requests https://api.jikan.moe/v3 using a default http.Client
+func NewJikanClient(opts ...Option) Client {
+	return newJikanClient(jikanV3BaseURL, opts...)
+}
+
+func newJikanClient(baseURL string, opts ...Option) *jikanClient {
+	client := &jikanClient{
+		baseURL:    baseURL,
+		client:     &http.Client{},
+		revalidate: defaultRevalidationWindow,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// get requests the url and decodes the response body into v
+func (ths *jikanClient) get(ctx context.Context, url string, v interface{}) error {
+	if ths.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ths.timeout)
+		defer cancel()
 	}
+
+	var cached *cachedResponse
+	if ths.cache != nil && !cacheBypassed(ctx) {
+		cached = ths.cachedResponse(url)
+		if cached != nil && cached.fresh(time.Now()) && json.Unmarshal(cached.Body, v) == nil {
+			return nil
+		}
+	}
+
+	resp, err := ths.do(ctx, url, cached.conditionalHeader())
+	if err != nil {
+		return err
+	}
+
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		ths.storeResponse(url, resp, cached.Body, cacheTTL(resp, nil, time.Now()), cached)
+		return json.Unmarshal(cached.Body, v)
+	}
+
+	err = ths.statusError(resp, url)
+	if err != nil {
+		return err
+	}
+
+	err = ths.contentTypeError(resp, url)
+	if err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, v)
+	if err != nil {
+		return err
+	}
+
+	ths.storeResponse(url, resp, body, cacheTTL(resp, body, time.Now()), nil)
+	return nil
+}
+
+// do sends a GET request to the url with the extra header, retrying it according to the retry policy
+func (ths *jikanClient) do(ctx context.Context, url string, header http.Header) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if ths.limiter != nil {
+			err := ths.limiter.Wait(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := ths.send(ctx, url, header)
+
+		wait, retry := ths.retry.backoff(ctx, attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		ths.retry.notify(RetryAttempt{
+			Attempt:  attempt,
+			URL:      url,
+			Response: resp,
+			Err:      err,
+			Wait:     wait,
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// send sends a single GET request to the url with the extra header
+func (ths *jikanClient) send(ctx context.Context, url string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range ths.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+
+	if ths.userAgent != "" {
+		req.Header.Set("User-Agent", ths.userAgent)
+	}
+
+	for key, values := range header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+
+	return ths.client.Do(req)
 }
@@ -0,0 +1,73 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClubStaff is a struct details of club's staff
+type ClubStaff struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Club is a struct of club details from MyAnimeList
+type Club struct {
+	ResponseMeta
+
+	MalID              int             `json:"mal_id"`
+	URL                string          `json:"url"`
+	ImageURL           string          `json:"image_url"`
+	Title              string          `json:"title"`
+	MembersCount       int             `json:"members_count"`
+	PicturesCount      int             `json:"pictures_count"`
+	Category           string          `json:"category"`
+	Created            time.Time       `json:"created"`
+	Type               string          `json:"type"`
+	Staff              []ClubStaff     `json:"staff"`
+	AnimeRelations     []AnimeResource `json:"anime_relations"`
+	MangaRelations     []AnimeResource `json:"manga_relations"`
+	CharacterRelations []AnimeResource `json:"character_relations"`
+}
+
+func (ths *jikanClient) GetClub(id int) (club Club, err error) {
+	return ths.GetClubContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetClubContext(ctx context.Context, id int) (club Club, err error) {
+	url := fmt.Sprintf("%s/club/%d", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &club)
+	return
+}
+
+// ===================================================================================================================================
+
+// ClubMembers is a struct list of club's members, maximum 36 members per page
+type ClubMembers struct {
+	ResponseMeta
+
+	Members []ClubMember `json:"members"`
+}
+
+// ClubMember is a struct details of club's member
+type ClubMember struct {
+	Username string `json:"username"`
+	URL      string `json:"url"`
+	ImageURL string `json:"image_url"`
+}
+
+// GetClubMembers return club's members per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetClubMembers(id, page int) (clubMembers ClubMembers, err error) {
+	return ths.GetClubMembersContext(context.Background(), id, page)
+}
+
+func (ths *jikanClient) GetClubMembersContext(ctx context.Context, id, page int) (clubMembers ClubMembers, err error) {
+	url := pagedURL(fmt.Sprintf("%s/club/%d/members", ths.baseURL, id), page)
+
+	err = ths.get(ctx, url, &clubMembers)
+	return
+}
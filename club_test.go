@@ -0,0 +1,214 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClubEndpoints(t *testing.T) {
+	Convey("Testing Club Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+		clubID := 1
+
+		Convey("Testing GetClub Method", func() {
+			expectedClub := Club{
+				MalID:         clubID,
+				URL:           "https://myanimelist.net/clubs.php?cid=1",
+				Title:         "Cowboy Bebop",
+				MembersCount:  1500,
+				PicturesCount: 20,
+				Category:      "Anime",
+				Type:          "public",
+				Staff: []ClubStaff{
+					ClubStaff{
+						Type: "Officer",
+						Name: "Xinil",
+						URL:  "https://myanimelist.net/profile/Xinil",
+					},
+				},
+				AnimeRelations: []AnimeResource{
+					AnimeResource{
+						MalID: 1,
+						Type:  "anime",
+						Name:  "Cowboy Bebop",
+						URL:   "https://myanimelist.net/anime/1/Cowboy_Bebop",
+					},
+				},
+				CharacterRelations: []AnimeResource{
+					AnimeResource{
+						MalID: 1,
+						Type:  "character",
+						Name:  "Spiegel, Spike",
+						URL:   "https://myanimelist.net/character/1/Spike_Spiegel",
+					},
+				},
+			}
+
+			expectedClubBytes, err := json.Marshal(expectedClub)
+			So(err, ShouldBeNil)
+
+			Convey("GetClub should return a Club given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedClubBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/club/1")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				club, err := jikan.GetClub(clubID)
+
+				So(club, ShouldResemble, expectedClub)
+				So(club.MalID, ShouldEqual, clubID)
+				So(club.Staff[0].Type, ShouldEqual, "Officer")
+				So(club.AnimeRelations[0].MalID, ShouldEqual, 1)
+				So(club.MangaRelations, ShouldBeEmpty)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetClub should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				club, err := jikan.GetClub(clubID)
+
+				So(club, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetClub should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				club, err := jikan.GetClub(0)
+
+				So(club, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetClub should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				club, err := jikan.GetClub(0)
+
+				So(club, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetClubMembers Method", func() {
+			expectedClubMembers := ClubMembers{
+				Members: []ClubMember{
+					ClubMember{
+						Username: "Xinil",
+						URL:      "https://myanimelist.net/profile/Xinil",
+					},
+				},
+			}
+
+			expectedClubMembersBytes, err := json.Marshal(expectedClubMembers)
+			So(err, ShouldBeNil)
+
+			Convey("GetClubMembers should return a ClubMembers given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedClubMembersBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/club/1/members/2")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				clubMembers, err := jikan.GetClubMembers(clubID, 2)
+
+				So(clubMembers, ShouldResemble, expectedClubMembers)
+				So(clubMembers.Members[0].Username, ShouldEqual, "Xinil")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetClubMembers should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				clubMembers, err := jikan.GetClubMembers(clubID, 2)
+
+				So(clubMembers, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetClubMembers should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				clubMembers, err := jikan.GetClubMembers(0, 0)
+
+				So(clubMembers, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetClubMembers should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				clubMembers, err := jikan.GetClubMembers(0, 0)
+
+				So(clubMembers, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,340 @@
+package gojikan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func contextCalls(jikan ContextClient) map[string]func(ctx context.Context) error {
+	return map[string]func(ctx context.Context) error{
+		"GetAnimeContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeContext(ctx, 1)
+			return err
+		},
+		"GetAnimeCharacterStaffContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeCharacterStaffContext(ctx, 1)
+			return err
+		},
+		"GetAnimeAllEpisodesContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeAllEpisodesContext(ctx, 1, 1)
+			return err
+		},
+		"GetAnimeRelatedNewsContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeRelatedNewsContext(ctx, 1)
+			return err
+		},
+		"GetAnimeRelatedPicturesContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeRelatedPicturesContext(ctx, 1)
+			return err
+		},
+		"GetAnimeRelatedVideosContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeRelatedVideosContext(ctx, 1)
+			return err
+		},
+		"GetAnimeRelatedStatsContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeRelatedStatsContext(ctx, 1)
+			return err
+		},
+		"GetAnimeRelatedForumContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeRelatedForumContext(ctx, 1)
+			return err
+		},
+		"GetAnimeRecommendationsContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeRecommendationsContext(ctx, 1)
+			return err
+		},
+		"GetAnimeBatch": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeBatch(ctx, []int{1, 2}, nil)
+			return err
+		},
+		"GetAnimeFull": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeFull(ctx, 1)
+			return err
+		},
+		"BuildFranchise": func(ctx context.Context) error {
+			_, err := jikan.BuildFranchise(ctx, 1, nil)
+			return err
+		},
+		"GetAnimeReviewsContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeReviewsContext(ctx, 1, 1)
+			return err
+		},
+		"GetMangaContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaContext(ctx, 1)
+			return err
+		},
+		"GetMangaCharactersContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaCharactersContext(ctx, 1)
+			return err
+		},
+		"GetMangaNewsContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaNewsContext(ctx, 1)
+			return err
+		},
+		"GetMangaPicturesContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaPicturesContext(ctx, 1)
+			return err
+		},
+		"GetMangaStatsContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaStatsContext(ctx, 1)
+			return err
+		},
+		"GetMangaForumContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaForumContext(ctx, 1)
+			return err
+		},
+		"GetMangaMoreInfoContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaMoreInfoContext(ctx, 1)
+			return err
+		},
+		"GetMangaRecommendationsContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaRecommendationsContext(ctx, 1)
+			return err
+		},
+		"GetMangaReviewsContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaReviewsContext(ctx, 1, 1)
+			return err
+		},
+		"GetMangaUserUpdatesContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaUserUpdatesContext(ctx, 1, 1)
+			return err
+		},
+		"GetCharacterContext": func(ctx context.Context) error {
+			_, err := jikan.GetCharacterContext(ctx, 1)
+			return err
+		},
+		"GetCharacterPicturesContext": func(ctx context.Context) error {
+			_, err := jikan.GetCharacterPicturesContext(ctx, 1)
+			return err
+		},
+		"GetPersonContext": func(ctx context.Context) error {
+			_, err := jikan.GetPersonContext(ctx, 1)
+			return err
+		},
+		"GetPersonPicturesContext": func(ctx context.Context) error {
+			_, err := jikan.GetPersonPicturesContext(ctx, 1)
+			return err
+		},
+		"SearchAnimeContext": func(ctx context.Context) error {
+			_, err := jikan.SearchAnimeContext(ctx, NewSearchQuery("bebop"))
+			return err
+		},
+		"SearchMangaContext": func(ctx context.Context) error {
+			_, err := jikan.SearchMangaContext(ctx, NewSearchQuery("bebop"))
+			return err
+		},
+		"SearchPeopleContext": func(ctx context.Context) error {
+			_, err := jikan.SearchPeopleContext(ctx, NewSearchQuery("bebop"))
+			return err
+		},
+		"SearchCharactersContext": func(ctx context.Context) error {
+			_, err := jikan.SearchCharactersContext(ctx, NewSearchQuery("bebop"))
+			return err
+		},
+		"GetSeasonContext": func(ctx context.Context) error {
+			_, err := jikan.GetSeasonContext(ctx, 1, SeasonFall)
+			return err
+		},
+		"GetSeasonArchiveContext": func(ctx context.Context) error {
+			_, err := jikan.GetSeasonArchiveContext(ctx)
+			return err
+		},
+		"GetSeasonLaterContext": func(ctx context.Context) error {
+			_, err := jikan.GetSeasonLaterContext(ctx)
+			return err
+		},
+		"GetScheduleContext": func(ctx context.Context) error {
+			_, err := jikan.GetScheduleContext(ctx, WeekdayMonday)
+			return err
+		},
+		"GetTopAnimeContext": func(ctx context.Context) error {
+			_, err := jikan.GetTopAnimeContext(ctx, 1, TopAnimeAiring)
+			return err
+		},
+		"GetTopMangaContext": func(ctx context.Context) error {
+			_, err := jikan.GetTopMangaContext(ctx, 1, TopMangaManga)
+			return err
+		},
+		"GetTopPeopleContext": func(ctx context.Context) error {
+			_, err := jikan.GetTopPeopleContext(ctx, 1)
+			return err
+		},
+		"GetTopCharactersContext": func(ctx context.Context) error {
+			_, err := jikan.GetTopCharactersContext(ctx, 1)
+			return err
+		},
+		"GetAnimeByGenreContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeByGenreContext(ctx, 1, 1)
+			return err
+		},
+		"GetMangaByGenreContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaByGenreContext(ctx, 1, 1)
+			return err
+		},
+		"GetAnimeByProducerContext": func(ctx context.Context) error {
+			_, err := jikan.GetAnimeByProducerContext(ctx, 1, 1)
+			return err
+		},
+		"GetMangaByMagazineContext": func(ctx context.Context) error {
+			_, err := jikan.GetMangaByMagazineContext(ctx, 1, 1)
+			return err
+		},
+		"ResolveResourceContext": func(ctx context.Context) error {
+			_, err := jikan.ResolveResourceContext(ctx, AnimeResource{MalID: 1, Type: "anime", URL: "https://myanimelist.net/anime/genre/1/Action"}, 1)
+			return err
+		},
+		"GetUserProfileContext": func(ctx context.Context) error {
+			_, err := jikan.GetUserProfileContext(ctx, "Nekomata1037")
+			return err
+		},
+		"GetUserHistoryContext": func(ctx context.Context) error {
+			_, err := jikan.GetUserHistoryContext(ctx, "Nekomata1037", HistoryAnime)
+			return err
+		},
+		"GetUserFriendsContext": func(ctx context.Context) error {
+			_, err := jikan.GetUserFriendsContext(ctx, "Nekomata1037", 1)
+			return err
+		},
+		"GetUserAnimeListContext": func(ctx context.Context) error {
+			_, err := jikan.GetUserAnimeListContext(ctx, "Nekomata1037", ListStatusAll, 1, nil)
+			return err
+		},
+		"GetUserMangaListContext": func(ctx context.Context) error {
+			_, err := jikan.GetUserMangaListContext(ctx, "Nekomata1037", ListStatusAll, 1, nil)
+			return err
+		},
+		"GetClubContext": func(ctx context.Context) error {
+			_, err := jikan.GetClubContext(ctx, 1)
+			return err
+		},
+		"GetClubMembersContext": func(ctx context.Context) error {
+			_, err := jikan.GetClubMembersContext(ctx, 1, 1)
+			return err
+		},
+		"GetMetaStatusContext": func(ctx context.Context) error {
+			_, err := jikan.GetMetaStatusContext(ctx)
+			return err
+		},
+		"GetMetaRequestsContext": func(ctx context.Context) error {
+			_, err := jikan.GetMetaRequestsContext(ctx, "Nekomata1037", MetaPeriodToday, 1)
+			return err
+		},
+	}
+}
+
+func TestContextClient(t *testing.T) {
+	Convey("Testing Context Client Methods", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		cancelingClient := &MockClient{
+			MockDo: func(req *http.Request) (*http.Response, error) {
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			},
+		}
+
+		Convey("Every context method should return context.Canceled given canceled context", func() {
+			jikan.client = cancelingClient
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			for name, call := range contextCalls(jikan) {
+				Convey(name+" should return context.Canceled", func() {
+					So(errors.Is(call(ctx), context.Canceled), ShouldBeTrue)
+				})
+			}
+		})
+
+		Convey("Every v4 context method should return context.Canceled given canceled context", func() {
+			jikanV4 := NewJikanV4Client().(*jikanV4Client)
+			jikanV4.client = cancelingClient
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			for name, call := range contextCalls(jikanV4) {
+				Convey("V4 "+name+" should return context.Canceled", func() {
+					So(errors.Is(call(ctx), context.Canceled), ShouldBeTrue)
+				})
+			}
+		})
+
+		Convey("GetAnimeAllEpisodesContext should stop waiting when the context is canceled in flight", func() {
+			started := make(chan struct{})
+			jikan.client = &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					close(started)
+					<-req.Context().Done()
+					return nil, req.Context().Err()
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				<-started
+				cancel()
+			}()
+
+			animeEpisodes, err := jikan.GetAnimeAllEpisodesContext(ctx, 1, 2)
+
+			So(animeEpisodes, ShouldBeZeroValue)
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		})
+
+		Convey("GetAnimeContext should propagate the context deadline to the request", func() {
+			deadline := time.Now().Add(time.Minute)
+			ctx, cancel := context.WithDeadline(context.Background(), deadline)
+			defer cancel()
+
+			jikan.client = &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					requestDeadline, ok := req.Context().Deadline()
+
+					So(ok, ShouldBeTrue)
+					So(requestDeadline, ShouldEqual, deadline)
+
+					return &http.Response{StatusCode: 404}, nil
+				},
+			}
+
+			_, err := jikan.GetAnimeContext(ctx, 1)
+
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+		})
+
+		Convey("GetAnimeContext should return context.DeadlineExceeded when the deadline passed", func() {
+			jikan.client = cancelingClient
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+			defer cancel()
+
+			anime, err := jikan.GetAnimeContext(ctx, 1)
+
+			So(anime, ShouldBeZeroValue)
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+		})
+
+		Convey("Methods without context should send requests without deadline", func() {
+			jikan.client = &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					_, ok := req.Context().Deadline()
+
+					So(ok, ShouldBeFalse)
+					So(req.Context().Err(), ShouldBeNil)
+
+					return &http.Response{StatusCode: 404}, nil
+				},
+			}
+
+			_, err := jikan.GetAnime(1)
+
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+		})
+	})
+}
@@ -0,0 +1,252 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// AnimeType is a type of the anime's format like TV or Movie
+type AnimeType string
+
+// AnimeType values spelled like Jikan's
+const (
+	AnimeTypeTV        AnimeType = "TV"
+	AnimeTypeOVA       AnimeType = "OVA"
+	AnimeTypeMovie     AnimeType = "Movie"
+	AnimeTypeSpecial   AnimeType = "Special"
+	AnimeTypeONA       AnimeType = "ONA"
+	AnimeTypeMusic     AnimeType = "Music"
+	AnimeTypeTVSpecial AnimeType = "TV Special"
+	AnimeTypeCM        AnimeType = "CM"
+	AnimeTypePV        AnimeType = "PV"
+)
+
+var animeTypes = newEnum([]string{
+	string(AnimeTypeTV),
+	string(AnimeTypeOVA),
+	string(AnimeTypeMovie),
+	string(AnimeTypeSpecial),
+	string(AnimeTypeONA),
+	string(AnimeTypeMusic),
+	string(AnimeTypeTVSpecial),
+	string(AnimeTypeCM),
+	string(AnimeTypePV),
+}, nil)
+
+// IsValid reports whether the type is one of the AnimeType constants
+func (ths AnimeType) IsValid() bool {
+	return animeTypes.valid(string(ths))
+}
+
+// UnmarshalJSON accepts the type in any letter case, unknown types are kept as they are
+func (ths *AnimeType) UnmarshalJSON(data []byte) error {
+	value, err := animeTypes.unmarshal(data)
+	*ths = AnimeType(value)
+	return err
+}
+
+// ===================================================================================================================================
+
+// AnimeStatus is a type of the anime's airing status
+type AnimeStatus string
+
+// AnimeStatus values spelled like Jikan's
+const (
+	AnimeStatusFinished    AnimeStatus = "Finished Airing"
+	AnimeStatusAiring      AnimeStatus = "Currently Airing"
+	AnimeStatusNotYetAired AnimeStatus = "Not yet aired"
+)
+
+var animeStatuses = newEnum([]string{
+	string(AnimeStatusFinished),
+	string(AnimeStatusAiring),
+	string(AnimeStatusNotYetAired),
+}, map[string]string{
+	"finished":      string(AnimeStatusFinished),
+	"complete":      string(AnimeStatusFinished),
+	"completed":     string(AnimeStatusFinished),
+	"airing":        string(AnimeStatusAiring),
+	"upcoming":      string(AnimeStatusNotYetAired),
+	"to_be_aired":   string(AnimeStatusNotYetAired),
+	"not yet aired": string(AnimeStatusNotYetAired),
+})
+
+// IsValid reports whether the status is one of the AnimeStatus constants
+func (ths AnimeStatus) IsValid() bool {
+	return animeStatuses.valid(string(ths))
+}
+
+// UnmarshalJSON accepts the status in any letter case and Jikan's search spellings
+// like "airing", unknown statuses are kept as they are
+func (ths *AnimeStatus) UnmarshalJSON(data []byte) error {
+	value, err := animeStatuses.unmarshal(data)
+	*ths = AnimeStatus(value)
+	return err
+}
+
+// ===================================================================================================================================
+
+// AnimeSource is a type of the anime's source material
+type AnimeSource string
+
+// AnimeSource values spelled like Jikan's
+const (
+	AnimeSourceOriginal     AnimeSource = "Original"
+	AnimeSourceManga        AnimeSource = "Manga"
+	AnimeSourceFourKoma     AnimeSource = "4-koma manga"
+	AnimeSourceWebManga     AnimeSource = "Web manga"
+	AnimeSourceDigitalManga AnimeSource = "Digital manga"
+	AnimeSourceNovel        AnimeSource = "Novel"
+	AnimeSourceLightNovel   AnimeSource = "Light novel"
+	AnimeSourceWebNovel     AnimeSource = "Web novel"
+	AnimeSourceVisualNovel  AnimeSource = "Visual novel"
+	AnimeSourceGame         AnimeSource = "Game"
+	AnimeSourceCardGame     AnimeSource = "Card game"
+	AnimeSourceBook         AnimeSource = "Book"
+	AnimeSourcePictureBook  AnimeSource = "Picture book"
+	AnimeSourceRadio        AnimeSource = "Radio"
+	AnimeSourceMusic        AnimeSource = "Music"
+	AnimeSourceMixedMedia   AnimeSource = "Mixed media"
+	AnimeSourceOther        AnimeSource = "Other"
+	AnimeSourceUnknown      AnimeSource = "Unknown"
+)
+
+var animeSources = newEnum([]string{
+	string(AnimeSourceOriginal),
+	string(AnimeSourceManga),
+	string(AnimeSourceFourKoma),
+	string(AnimeSourceWebManga),
+	string(AnimeSourceDigitalManga),
+	string(AnimeSourceNovel),
+	string(AnimeSourceLightNovel),
+	string(AnimeSourceWebNovel),
+	string(AnimeSourceVisualNovel),
+	string(AnimeSourceGame),
+	string(AnimeSourceCardGame),
+	string(AnimeSourceBook),
+	string(AnimeSourcePictureBook),
+	string(AnimeSourceRadio),
+	string(AnimeSourceMusic),
+	string(AnimeSourceMixedMedia),
+	string(AnimeSourceOther),
+	string(AnimeSourceUnknown),
+}, map[string]string{
+	"4-koma": string(AnimeSourceFourKoma),
+})
+
+// IsValid reports whether the source is one of the AnimeSource constants
+func (ths AnimeSource) IsValid() bool {
+	return animeSources.valid(string(ths))
+}
+
+// UnmarshalJSON accepts the source in any letter case, unknown sources are kept as they are
+func (ths *AnimeSource) UnmarshalJSON(data []byte) error {
+	value, err := animeSources.unmarshal(data)
+	*ths = AnimeSource(value)
+	return err
+}
+
+// ===================================================================================================================================
+
+// Rating is a type of the anime's age rating
+type Rating string
+
+// Rating values spelled like Jikan's
+const (
+	RatingG     Rating = "G - All Ages"
+	RatingPG    Rating = "PG - Children"
+	RatingPG13  Rating = "PG-13 - Teens 13 or older"
+	RatingR17   Rating = "R - 17+ (violence & profanity)"
+	RatingRPlus Rating = "R+ - Mild Nudity"
+	RatingRx    Rating = "Rx - Hentai"
+	RatingNone  Rating = "None"
+)
+
+var ratings = newEnum([]string{
+	string(RatingG),
+	string(RatingPG),
+	string(RatingPG13),
+	string(RatingR17),
+	string(RatingRPlus),
+	string(RatingRx),
+	string(RatingNone),
+}, map[string]string{
+	"g":       string(RatingG),
+	"pg":      string(RatingPG),
+	"pg13":    string(RatingPG13),
+	"pg-13":   string(RatingPG13),
+	"r17":     string(RatingR17),
+	"r - 17+": string(RatingR17),
+	"r+":      string(RatingRPlus),
+	"rx":      string(RatingRx),
+})
+
+// IsValid reports whether the rating is one of the Rating constants
+func (ths Rating) IsValid() bool {
+	return ratings.valid(string(ths))
+}
+
+// MinimumAge return the minimum age of the audience of the rating,
+// unknown ratings and RatingNone return 0
+func (ths Rating) MinimumAge() int {
+	switch ths {
+	case RatingPG13:
+		return 13
+	case RatingR17, RatingRPlus:
+		return 17
+	case RatingRx:
+		return 18
+	}
+
+	return 0
+}
+
+// UnmarshalJSON accepts the rating in any letter case and Jikan's search spellings
+// like "pg13", unknown ratings are kept as they are
+func (ths *Rating) UnmarshalJSON(data []byte) error {
+	value, err := ratings.unmarshal(data)
+	*ths = Rating(value)
+	return err
+}
+
+// ===================================================================================================================================
+
+// enum is a lookup of the values of a string enum by their lowercased spellings
+type enum map[string]string
+
+func newEnum(values []string, aliases map[string]string) enum {
+	lookup := make(enum)
+	for _, value := range values {
+		lookup[strings.ToLower(value)] = value
+	}
+
+	for alias, canonical := range aliases {
+		lookup[alias] = canonical
+	}
+
+	return lookup
+}
+
+func (ths enum) valid(value string) bool {
+	canonical, ok := ths[strings.ToLower(value)]
+	return ok && canonical == value
+}
+
+// unmarshal decodes the JSON string into its canonical value, or the string itself when it is unknown
+func (ths enum) unmarshal(data []byte) (string, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return "", nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", err
+	}
+
+	if canonical, ok := ths[strings.ToLower(strings.TrimSpace(value))]; ok {
+		return canonical, nil
+	}
+
+	return value, nil
+}
@@ -0,0 +1,88 @@
+package gojikan
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnimeEnums(t *testing.T) {
+	Convey("Testing Anime Enums", t, func() {
+		Convey("Anime should decode Jikan's spellings into the enum constants", func() {
+			var anime Anime
+			err := json.Unmarshal([]byte(`{
+				"type": "TV",
+				"source": "light novel",
+				"status": "Finished Airing",
+				"rating": "R - 17+ (violence & profanity)"
+			}`), &anime)
+
+			So(err, ShouldBeNil)
+			So(anime.Type, ShouldEqual, AnimeTypeTV)
+			So(anime.Source, ShouldEqual, AnimeSourceLightNovel)
+			So(anime.Status, ShouldEqual, AnimeStatusFinished)
+			So(anime.Rating, ShouldEqual, RatingR17)
+			So(anime.Rating.MinimumAge(), ShouldEqual, 17)
+		})
+
+		Convey("Enums should accept other letter cases and Jikan's search spellings", func() {
+			var status AnimeStatus
+			So(json.Unmarshal([]byte(`"airing"`), &status), ShouldBeNil)
+			So(status, ShouldEqual, AnimeStatusAiring)
+
+			So(json.Unmarshal([]byte(`"Not Yet Aired"`), &status), ShouldBeNil)
+			So(status, ShouldEqual, AnimeStatusNotYetAired)
+
+			var rating Rating
+			So(json.Unmarshal([]byte(`"pg13"`), &rating), ShouldBeNil)
+			So(rating, ShouldEqual, RatingPG13)
+
+			var animeType AnimeType
+			So(json.Unmarshal([]byte(`"movie"`), &animeType), ShouldBeNil)
+			So(animeType, ShouldEqual, AnimeTypeMovie)
+		})
+
+		Convey("Enums should keep unknown values and decode null as empty", func() {
+			var animeType AnimeType
+			So(json.Unmarshal([]byte(`"Hologram"`), &animeType), ShouldBeNil)
+			So(animeType, ShouldEqual, AnimeType("Hologram"))
+			So(animeType.IsValid(), ShouldBeFalse)
+
+			var source AnimeSource = AnimeSourceManga
+			So(json.Unmarshal([]byte(`null`), &source), ShouldBeNil)
+			So(source, ShouldBeEmpty)
+
+			var rating Rating
+			So(json.Unmarshal([]byte(`17`), &rating), ShouldNotBeNil)
+		})
+
+		Convey("IsValid should only accept the enum constants", func() {
+			So(AnimeTypeONA.IsValid(), ShouldBeTrue)
+			So(AnimeType("tv").IsValid(), ShouldBeFalse)
+			So(AnimeStatusNotYetAired.IsValid(), ShouldBeTrue)
+			So(AnimeStatus("").IsValid(), ShouldBeFalse)
+			So(AnimeSourceFourKoma.IsValid(), ShouldBeTrue)
+			So(RatingNone.IsValid(), ShouldBeTrue)
+			So(Rating("NC-17").IsValid(), ShouldBeFalse)
+		})
+
+		Convey("MinimumAge should return the minimum age of every rating", func() {
+			So(RatingG.MinimumAge(), ShouldEqual, 0)
+			So(RatingPG.MinimumAge(), ShouldEqual, 0)
+			So(RatingPG13.MinimumAge(), ShouldEqual, 13)
+			So(RatingR17.MinimumAge(), ShouldEqual, 17)
+			So(RatingRPlus.MinimumAge(), ShouldEqual, 17)
+			So(RatingRx.MinimumAge(), ShouldEqual, 18)
+			So(RatingNone.MinimumAge(), ShouldEqual, 0)
+		})
+
+		Convey("Enums should encode back to Jikan's spellings", func() {
+			data, err := json.Marshal(Anime{Type: AnimeTypeTV, Rating: RatingPG13})
+
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, `"type":"TV"`)
+			So(string(data), ShouldContainSubstring, `"rating":"PG-13 - Teens 13 or older"`)
+		})
+	})
+}
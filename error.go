@@ -1,6 +1,14 @@
 package gojikan
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+)
 
 const (
 	// InvalidRequestError is an error message for status code 400
@@ -20,23 +28,182 @@ const (
 
 	// MyAnimeListError is an error message for status code 503
 	MyAnimeListError = "Something is not working in MyAnimeList"
+
+	// UnexpectedStatusError is an error message for the other non-2xx status codes
+	UnexpectedStatusError = "Unexpected status code returned by Jikan API"
+
+	// UnexpectedContentTypeError is an error message for a successful response that is not JSON
+	UnexpectedContentTypeError = "Unexpected content type returned by Jikan API"
 )
 
+// maxBodySnippet is the maximum length of the raw body attached to an APIError
+const maxBodySnippet = 512
+
+var (
+	// ErrInvalidRequest is returned for status code 400
+	ErrInvalidRequest = errors.New(InvalidRequestError)
+
+	// ErrNotFound is returned for status code 404
+	ErrNotFound = errors.New(ResourceNotFoundError)
+
+	// ErrMethodNotAllowed is returned for status code 405
+	ErrMethodNotAllowed = errors.New(MethodNotAllowedError)
+
+	// ErrRateLimited is returned for status code 429
+	ErrRateLimited = errors.New(RateLimitedError)
+
+	// ErrJikanAPI is returned for status code 500
+	ErrJikanAPI = errors.New(JikanAPIError)
+
+	// ErrMyAnimeList is returned for status code 503
+	ErrMyAnimeList = errors.New(MyAnimeListError)
+
+	// ErrUnexpectedStatus is returned for the other non-2xx status codes
+	ErrUnexpectedStatus = errors.New(UnexpectedStatusError)
+
+	// ErrUnexpectedContentType is returned when a successful response is not JSON
+	ErrUnexpectedContentType = errors.New(UnexpectedContentTypeError)
+
+	// ErrUpstream matches every 5xx error, either from Jikan API or MyAnimeList
+	ErrUpstream = errors.New("Something is not working in the upstream")
+)
+
+var statusErrors = map[int]error{
+	400: ErrInvalidRequest,
+	404: ErrNotFound,
+	405: ErrMethodNotAllowed,
+	429: ErrRateLimited,
+	500: ErrJikanAPI,
+	503: ErrMyAnimeList,
+}
+
+// APIError is an error returned when Jikan API responds with an error status code
+// or with a body that is not JSON
+// Type, Message, Detail and ReportURL are decoded from Jikan's error body when it has one
+// and Body is the beginning of the raw body
+// Use errors.Is with the Err sentinels to check the kind of the error
+type APIError struct {
+	StatusCode  int    `json:"status"`
+	Method      string `json:"-"`
+	URL         string `json:"-"`
+	ContentType string `json:"-"`
+	Body        string `json:"-"`
+	Type        string `json:"type"`
+	Message     string `json:"message"`
+	Detail      string `json:"error"`
+	ReportURL   string `json:"report_url"`
+
+	err error
+}
+
+// Error return the error message of the status code
+func (ths *APIError) Error() string {
+	err := ths.Unwrap()
+	switch err {
+	case nil:
+		if ths.Message != "" {
+			return fmt.Sprintf("Jikan API error with status code %d: %s", ths.StatusCode, ths.Message)
+		}
+
+		return fmt.Sprintf("Jikan API error with status code %d", ths.StatusCode)
+	case ErrUnexpectedStatus:
+		return fmt.Sprintf("%s: %d", err, ths.StatusCode)
+	case ErrUnexpectedContentType:
+		return fmt.Sprintf("%s: %q", err, ths.ContentType)
+	}
+
+	return err.Error()
+}
+
+// Unwrap return the sentinel error of the status code,
+// which is also derived from StatusCode for an APIError built outside of the client
+func (ths *APIError) Unwrap() error {
+	if ths.err != nil {
+		return ths.err
+	}
+
+	if err, ok := statusErrors[ths.StatusCode]; ok {
+		return err
+	}
+
+	if ths.StatusCode >= 300 && ths.StatusCode <= 599 {
+		return ErrUnexpectedStatus
+	}
+
+	return nil
+}
+
+// Is reports whether the error matches target, ErrUpstream matches every 5xx error
+func (ths *APIError) Is(target error) bool {
+	return target == ErrUpstream && ths.StatusCode >= 500
+}
+
 func (ths *jikanClient) checkStatusError(status int) error {
-	switch status {
-	case 400:
-		return errors.New(InvalidRequestError)
-	case 404:
-		return errors.New(ResourceNotFoundError)
-	case 405:
-		return errors.New(MethodNotAllowedError)
-	case 429:
-		return errors.New(RateLimitedError)
-	case 500:
-		return errors.New(JikanAPIError)
-	case 503:
-		return errors.New(MyAnimeListError)
+	if err, ok := statusErrors[status]; ok {
+		return &APIError{StatusCode: status, err: err}
+	}
+
+	if status < 200 || status > 299 {
+		return &APIError{StatusCode: status, err: ErrUnexpectedStatus}
 	}
 
 	return nil
 }
+
+// statusError return an *APIError of the response to the GET request of the url,
+// or nil when the status code is not an error
+func (ths *jikanClient) statusError(resp *http.Response, url string) error {
+	err := ths.checkStatusError(resp.StatusCode)
+	if err == nil {
+		return nil
+	}
+
+	apiErr := err.(*APIError)
+	body := apiErr.read(resp, url)
+	json.Unmarshal(body, apiErr)
+	apiErr.StatusCode = resp.StatusCode
+
+	return apiErr
+}
+
+// contentTypeError return an *APIError when the response is not JSON
+// A response without Content-Type is assumed to be JSON
+func (ths *jikanClient) contentTypeError(resp *http.Response, url string) error {
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")) {
+		return nil
+	}
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, err: ErrUnexpectedContentType}
+	apiErr.read(resp, url)
+
+	return apiErr
+}
+
+// read fills the request and the body snippet of the response and return the whole body
+func (ths *APIError) read(resp *http.Response, url string) []byte {
+	ths.Method = http.MethodGet
+	ths.URL = url
+	ths.ContentType = resp.Header.Get("Content-Type")
+
+	if resp.Body == nil {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil
+	}
+
+	ths.Body = string(body)
+	if len(body) > maxBodySnippet {
+		ths.Body = string(body[:maxBodySnippet])
+	}
+
+	return body
+}
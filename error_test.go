@@ -1,6 +1,10 @@
 package gojikan
 
 import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -52,6 +56,16 @@ func TestCheckStatusError(t *testing.T) {
 			So(err.Error(), ShouldEqual, MyAnimeListError)
 		})
 
+		Convey("checkStatusError() with unmapped non-2xx status should return error UnexpectedStatusError", func() {
+			for _, status := range []int{301, 401, 403, 408, 502, 504} {
+				err := client.checkStatusError(status)
+
+				So(err, ShouldNotBeNil)
+				So(errors.Is(err, ErrUnexpectedStatus), ShouldBeTrue)
+				So(err.Error(), ShouldStartWith, UnexpectedStatusError)
+			}
+		})
+
 		Convey("checkStatusError() with status 200 should return nil", func() {
 			err := client.checkStatusError(200)
 
@@ -59,3 +73,161 @@ func TestCheckStatusError(t *testing.T) {
 		})
 	})
 }
+
+func TestAPIError(t *testing.T) {
+	Convey("Testing APIError", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		Convey("APIError should match its sentinel with errors.Is and keep the error message", func() {
+			cases := map[int]error{
+				400: ErrInvalidRequest,
+				404: ErrNotFound,
+				405: ErrMethodNotAllowed,
+				429: ErrRateLimited,
+				500: ErrJikanAPI,
+				503: ErrMyAnimeList,
+			}
+
+			for status, sentinel := range cases {
+				err := jikan.checkStatusError(status)
+
+				So(errors.Is(err, sentinel), ShouldBeTrue)
+				So(err.Error(), ShouldEqual, sentinel.Error())
+				So(errors.Is(err, ErrUpstream), ShouldEqual, status >= 500)
+			}
+		})
+
+		Convey("APIError built without the client should not panic and still match its sentinel", func() {
+			err := &APIError{StatusCode: 404}
+
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+
+			err = &APIError{StatusCode: 502}
+
+			So(err.Error(), ShouldEqual, UnexpectedStatusError+": 502")
+			So(errors.Is(err, ErrUnexpectedStatus), ShouldBeTrue)
+			So(errors.Is(err, ErrUpstream), ShouldBeTrue)
+
+			err = &APIError{Message: "Something went wrong"}
+
+			So(err.Error(), ShouldEqual, "Jikan API error with status code 0: Something went wrong")
+			So(err.Unwrap(), ShouldBeNil)
+			So((&APIError{}).Error(), ShouldEqual, "Jikan API error with status code 0")
+		})
+
+		Convey("Client should return APIError with the request and the decoded Jikan error", func() {
+			jikan.client = &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 404,
+						Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+							"status": 404,
+							"type": "BadResponseException",
+							"message": "Resource does not exist",
+							"error": "404 on https://myanimelist.net/anime/0/",
+							"report_url": "https://github.com/jikan-me/jikan-rest/issues/new"
+						}`))),
+					}, nil
+				},
+			}
+
+			_, err := jikan.GetAnime(0)
+
+			var apiErr *APIError
+			So(errors.As(err, &apiErr), ShouldBeTrue)
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			So(apiErr.StatusCode, ShouldEqual, 404)
+			So(apiErr.Method, ShouldEqual, http.MethodGet)
+			So(apiErr.URL, ShouldEqual, "https://api.jikan.moe/v3/anime/0")
+			So(apiErr.Type, ShouldEqual, "BadResponseException")
+			So(apiErr.Message, ShouldEqual, "Resource does not exist")
+			So(apiErr.Detail, ShouldEqual, "404 on https://myanimelist.net/anime/0/")
+			So(apiErr.ReportURL, ShouldEqual, "https://github.com/jikan-me/jikan-rest/issues/new")
+		})
+
+		Convey("Client should return APIError without Jikan error when the body is not JSON", func() {
+			jikan.client = &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 503,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`<html>Service Unavailable</html>`))),
+					}, nil
+				},
+			}
+
+			_, err := jikan.GetAnime(1)
+
+			var apiErr *APIError
+			So(errors.As(err, &apiErr), ShouldBeTrue)
+			So(errors.Is(err, ErrMyAnimeList), ShouldBeTrue)
+			So(errors.Is(err, ErrUpstream), ShouldBeTrue)
+			So(apiErr.StatusCode, ShouldEqual, 503)
+			So(apiErr.Type, ShouldBeEmpty)
+		})
+
+		Convey("Client should return APIError with the body snippet for unmapped status", func() {
+			jikan.client = &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 502,
+						Header:     http.Header{"Content-Type": []string{"text/html"}},
+						Body:       ioutil.NopCloser(bytes.NewReader(bytes.Repeat([]byte("a"), 1024))),
+					}, nil
+				},
+			}
+
+			anime, err := jikan.GetAnime(1)
+
+			var apiErr *APIError
+			So(anime, ShouldResemble, Anime{})
+			So(errors.As(err, &apiErr), ShouldBeTrue)
+			So(errors.Is(err, ErrUnexpectedStatus), ShouldBeTrue)
+			So(errors.Is(err, ErrUpstream), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, UnexpectedStatusError+": 502")
+			So(apiErr.ContentType, ShouldEqual, "text/html")
+			So(apiErr.Body, ShouldHaveLength, 512)
+		})
+
+		Convey("Client should return APIError when a successful response is not JSON", func() {
+			jikan.client = &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`<html>Cloudflare</html>`))),
+					}, nil
+				},
+			}
+
+			anime, err := jikan.GetAnime(1)
+
+			var apiErr *APIError
+			So(anime, ShouldResemble, Anime{})
+			So(errors.As(err, &apiErr), ShouldBeTrue)
+			So(errors.Is(err, ErrUnexpectedContentType), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, UnexpectedContentTypeError+`: "text/html; charset=utf-8"`)
+			So(apiErr.StatusCode, ShouldEqual, 200)
+			So(apiErr.URL, ShouldEqual, "https://api.jikan.moe/v3/anime/1")
+			So(apiErr.Body, ShouldEqual, "<html>Cloudflare</html>")
+		})
+
+		Convey("Client should decode successful response with JSON content type", func() {
+			jikan.client = &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"mal_id":1}`))),
+					}, nil
+				},
+			}
+
+			anime, err := jikan.GetAnime(1)
+
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+		})
+	})
+}
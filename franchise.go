@@ -0,0 +1,225 @@
+package gojikan
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// defaultFranchiseMaxNodes is the maximum number of nodes of a franchise when it is not set
+const defaultFranchiseMaxNodes = 50
+
+// DefaultFranchiseRelations are the relations followed by BuildFranchise when none is given
+// Character and Other relations are left out as they often link to unrelated franchises
+var DefaultFranchiseRelations = []Relation{
+	RelationAdaptation,
+	RelationSideStory,
+	RelationSummary,
+	RelationSequel,
+	RelationPrequel,
+	RelationAlternativeVersion,
+	RelationAlternativeSetting,
+	RelationSpinOff,
+	RelationParentStory,
+	RelationFullStory,
+}
+
+// FranchiseOptions is a struct of limits and options of BuildFranchise
+// MaxDepth is the number of relation edges away from the root that is still fetched, 0 means unlimited
+// MaxNodes is the maximum number of anime and manga in the franchise, it defaults to 50
+// Relations are the relations to follow, they default to DefaultFranchiseRelations
+// Concurrency is the number of anime fetched at the same time, it defaults to 4
+type FranchiseOptions struct {
+	MaxDepth    int
+	MaxNodes    int
+	Relations   []Relation
+	Concurrency int
+}
+
+func (ths *FranchiseOptions) maxNodes() int {
+	if ths == nil || ths.MaxNodes <= 0 {
+		return defaultFranchiseMaxNodes
+	}
+
+	return ths.MaxNodes
+}
+
+func (ths *FranchiseOptions) expands(depth int) bool {
+	return ths == nil || ths.MaxDepth <= 0 || depth < ths.MaxDepth
+}
+
+func (ths *FranchiseOptions) follows(relation Relation) bool {
+	relations := DefaultFranchiseRelations
+	if ths != nil && len(ths.Relations) > 0 {
+		relations = ths.Relations
+	}
+
+	for _, follow := range relations {
+		if strings.EqualFold(string(follow), string(relation)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ths *FranchiseOptions) batch() *BatchOptions {
+	if ths == nil {
+		return nil
+	}
+
+	return &BatchOptions{Concurrency: ths.Concurrency}
+}
+
+// FranchiseNode is a struct of an anime or manga in the franchise
+// Anime is only set for the anime that are fetched, manga are not fetched
+// Err is the error of fetching the anime
+type FranchiseNode struct {
+	Resource AnimeResource
+	Depth    int
+	Anime    *Anime
+	Err      error
+}
+
+// IsAnime reports whether the node is an anime
+func (ths *FranchiseNode) IsAnime() bool {
+	return strings.EqualFold(ths.Resource.Type, "anime")
+}
+
+// FranchiseEdge is a struct of a relation from an anime to another anime or manga
+type FranchiseEdge struct {
+	From     AnimeResource
+	To       AnimeResource
+	Relation Relation
+}
+
+// Franchise is a struct of the graph of anime and manga related to the root anime
+// Nodes are in the order they are found, starting from the root
+type Franchise struct {
+	Root  *FranchiseNode
+	Nodes []*FranchiseNode
+	Edges []FranchiseEdge
+	index map[franchiseKey]*FranchiseNode
+}
+
+type franchiseKey struct {
+	Type  string
+	MalID int
+}
+
+func newFranchiseKey(resource AnimeResource) franchiseKey {
+	return franchiseKey{
+		Type:  strings.ToLower(resource.Type),
+		MalID: resource.MalID,
+	}
+}
+
+// Node return the node of the resource type, anime or manga, with the id, or nil if it is not in the franchise
+func (ths *Franchise) Node(resourceType string, id int) *FranchiseNode {
+	return ths.index[franchiseKey{Type: strings.ToLower(resourceType), MalID: id}]
+}
+
+func (ths *Franchise) add(resource AnimeResource, depth int) *FranchiseNode {
+	if ths.index == nil {
+		ths.index = make(map[franchiseKey]*FranchiseNode)
+	}
+
+	node := &FranchiseNode{Resource: resource, Depth: depth}
+	ths.index[newFranchiseKey(resource)] = node
+	ths.Nodes = append(ths.Nodes, node)
+
+	return node
+}
+
+// WatchOrder return the fetched anime of the franchise sorted by when they started airing
+// Anime without aired date are put last
+func (ths *Franchise) WatchOrder() (animes []Anime) {
+	for _, node := range ths.Nodes {
+		if node.Anime != nil {
+			animes = append(animes, *node.Anime)
+		}
+	}
+
+	sort.SliceStable(animes, func(i, j int) bool {
+		from, to := animes[i].Aired.From, animes[j].Aired.From
+		if from.IsZero() || to.IsZero() {
+			return !from.IsZero() && to.IsZero()
+		}
+
+		return from.Before(to)
+	})
+
+	return
+}
+
+// BuildFranchise return the franchise of the anime by walking its relations breadth-first
+// Every level of the walk is fetched like GetAnimeBatch, so it goes through the client's rate limiter
+// err is only returned when the root anime failed or the context is done
+func (ths *jikanClient) BuildFranchise(ctx context.Context, rootID int, opts *FranchiseOptions) (franchise Franchise, err error) {
+	return buildFranchise(ctx, ths, rootID, opts)
+}
+
+func buildFranchise(ctx context.Context, client ContextClient, rootID int, opts *FranchiseOptions) (franchise Franchise, err error) {
+	franchise.Root = franchise.add(AnimeResource{MalID: rootID, Type: "anime"}, 0)
+
+	frontier := []int{rootID}
+	for depth := 0; len(frontier) > 0; depth++ {
+		results, batchErr := getAnimeBatch(ctx, client, frontier, opts.batch())
+		if batchErr != nil {
+			err = batchErr
+			return
+		}
+
+		var next []int
+		for _, result := range results {
+			node := franchise.Node("anime", result.ID)
+			if result.Err != nil {
+				node.Err = result.Err
+				if node == franchise.Root {
+					err = result.Err
+					return
+				}
+				continue
+			}
+
+			anime := result.Anime
+			node.Anime = &anime
+			node.Resource.Name = anime.Title
+			node.Resource.URL = anime.URL
+
+			if !opts.expands(depth) {
+				continue
+			}
+
+			anime.Related.each(func(relation Relation, resources []AnimeResource) {
+				if !opts.follows(relation) {
+					return
+				}
+
+				for _, resource := range resources {
+					target := franchise.index[newFranchiseKey(resource)]
+					if target == nil {
+						if len(franchise.Nodes) >= opts.maxNodes() {
+							continue
+						}
+
+						target = franchise.add(resource, depth+1)
+						if target.IsAnime() {
+							next = append(next, resource.MalID)
+						}
+					}
+
+					franchise.Edges = append(franchise.Edges, FranchiseEdge{
+						From:     node.Resource,
+						To:       target.Resource,
+						Relation: relation,
+					})
+				}
+			})
+		}
+
+		frontier = next
+	}
+
+	return
+}
@@ -0,0 +1,132 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildFranchise(t *testing.T) {
+	Convey("Testing BuildFranchise Method", t, func() {
+		animes := map[string]string{
+			"/v3/anime/2": `{"mal_id":2,"title":"Season 2","aired":{"from":"2012-01-01T00:00:00+00:00"},"related":{
+				"Prequel":[{"mal_id":1,"type":"anime","name":"Season 1"}],
+				"Sequel":[{"mal_id":3,"type":"anime","name":"Season 3"}]
+			}}`,
+			"/v3/anime/1": `{"mal_id":1,"title":"Season 1","aired":{"from":"2010-01-01T00:00:00+00:00"},"related":{
+				"Adaptation":[{"mal_id":100,"type":"manga","name":"Manga"}],
+				"Sequel":[{"mal_id":2,"type":"anime","name":"Season 2"}],
+				"Side story":[{"mal_id":4,"type":"anime","name":"OVA"}],
+				"Character":[{"mal_id":99,"type":"anime","name":"Crossover"}]
+			}}`,
+			"/v3/anime/3": `{"mal_id":3,"title":"Season 3","aired":{"from":"2014-01-01T00:00:00+00:00"},"related":{
+				"Prequel":[{"mal_id":2,"type":"anime","name":"Season 2"}]
+			}}`,
+			"/v3/anime/4": `{"mal_id":4,"title":"OVA","related":[]}`,
+		}
+
+		var mu sync.Mutex
+		var paths []string
+		jikan := NewJikanClient(WithHTTPClient(&MockClient{
+			MockDo: func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				paths = append(paths, req.URL.Path)
+				mu.Unlock()
+
+				body, ok := animes[req.URL.Path]
+				if !ok {
+					return &http.Response{
+						StatusCode: 404,
+						Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(body)),
+				}, nil
+			},
+		}))
+
+		Convey("BuildFranchise should walk every followed relation breadth-first", func() {
+			franchise, err := jikan.BuildFranchise(context.Background(), 2, nil)
+
+			So(err, ShouldBeNil)
+			So(franchise.Root.Anime.Title, ShouldEqual, "Season 2")
+			So(franchise.Nodes, ShouldHaveLength, 5)
+			So(paths, ShouldHaveLength, 4)
+			So(franchise.Node("anime", 99), ShouldBeNil)
+
+			manga := franchise.Node("manga", 100)
+			So(manga, ShouldNotBeNil)
+			So(manga.IsAnime(), ShouldBeFalse)
+			So(manga.Anime, ShouldBeNil)
+			So(manga.Depth, ShouldEqual, 2)
+
+			So(franchise.Node("anime", 4).Depth, ShouldEqual, 2)
+			So(franchise.Edges, ShouldContain, FranchiseEdge{
+				From:     AnimeResource{MalID: 2, Type: "anime", Name: "Season 2"},
+				To:       AnimeResource{MalID: 3, Type: "anime", Name: "Season 3"},
+				Relation: RelationSequel,
+			})
+			So(franchise.Edges, ShouldContain, FranchiseEdge{
+				From:     AnimeResource{MalID: 1, Type: "anime", Name: "Season 1"},
+				To:       AnimeResource{MalID: 100, Type: "manga", Name: "Manga"},
+				Relation: RelationAdaptation,
+			})
+			So(franchise.Edges, ShouldHaveLength, 6)
+		})
+
+		Convey("WatchOrder should sort the anime by aired date with unknown dates last", func() {
+			franchise, err := jikan.BuildFranchise(context.Background(), 3, nil)
+			So(err, ShouldBeNil)
+
+			var titles []string
+			for _, anime := range franchise.WatchOrder() {
+				titles = append(titles, anime.Title)
+			}
+
+			So(titles, ShouldResemble, []string{"Season 1", "Season 2", "Season 3", "OVA"})
+		})
+
+		Convey("BuildFranchise should stop at the depth and size limits", func() {
+			franchise, err := jikan.BuildFranchise(context.Background(), 2, &FranchiseOptions{MaxDepth: 1})
+
+			So(err, ShouldBeNil)
+			So(franchise.Nodes, ShouldHaveLength, 3)
+			So(franchise.Node("anime", 1).Anime, ShouldNotBeNil)
+			So(franchise.Node("anime", 4), ShouldBeNil)
+
+			paths = nil
+			franchise, err = jikan.BuildFranchise(context.Background(), 2, &FranchiseOptions{MaxNodes: 2})
+
+			So(err, ShouldBeNil)
+			So(franchise.Nodes, ShouldHaveLength, 2)
+			So(paths, ShouldHaveLength, 2)
+		})
+
+		Convey("BuildFranchise should follow only the given relations", func() {
+			franchise, err := jikan.BuildFranchise(context.Background(), 1, &FranchiseOptions{
+				Relations: []Relation{RelationCharacter},
+			})
+
+			So(err, ShouldBeNil)
+			So(franchise.Nodes, ShouldHaveLength, 2)
+			So(errors.Is(franchise.Node("anime", 99).Err, ErrNotFound), ShouldBeTrue)
+		})
+
+		Convey("BuildFranchise should return the error of the root anime", func() {
+			franchise, err := jikan.BuildFranchise(context.Background(), 404, nil)
+
+			So(errors.Is(err, ErrNotFound), ShouldBeTrue)
+			So(franchise.Root.Err, ShouldEqual, err)
+		})
+	})
+}
@@ -0,0 +1,153 @@
+package gojikan
+
+import (
+	"context"
+	"sync"
+)
+
+// Part is a type of the anime's sub-resources fetched by GetAnimeFull
+type Part string
+
+const (
+	// PartCharacterStaff is the anime's characters and staff
+	PartCharacterStaff Part = "characters_staff"
+
+	// PartEpisodes is the first page of the anime's episodes
+	PartEpisodes Part = "episodes"
+
+	// PartNews is the anime's related news
+	PartNews Part = "news"
+
+	// PartPictures is the anime's related pictures
+	PartPictures Part = "pictures"
+
+	// PartVideos is the anime's related videos
+	PartVideos Part = "videos"
+
+	// PartStats is the anime's related stats
+	PartStats Part = "stats"
+
+	// PartForum is the anime's related forum topics
+	PartForum Part = "forum"
+
+	// PartRecommendations is the anime's recommendations
+	PartRecommendations Part = "recommendations"
+
+	// PartReviews is the first page of the anime's reviews
+	PartReviews Part = "reviews"
+)
+
+// AllParts are every Part of the anime, GetAnimeFull fetches them when no part is given
+var AllParts = []Part{
+	PartCharacterStaff,
+	PartEpisodes,
+	PartNews,
+	PartPictures,
+	PartVideos,
+	PartStats,
+	PartForum,
+	PartRecommendations,
+	PartReviews,
+}
+
+// AnimeFull is a struct of the anime with its sub-resources
+// Parts that are not included or failed are left empty, Errors has the error of every failed part
+type AnimeFull struct {
+	Anime           Anime
+	CharacterStaff  AnimeCharacterStaff
+	Episodes        AnimeEpisodes
+	News            AnimeNews
+	Pictures        AnimePictures
+	Videos          AnimeVideos
+	Stats           AnimeStats
+	Forum           AnimeForum
+	Recommendations AnimeRecommendations
+	Reviews         AnimeReviews
+	Errors          map[Part]error
+}
+
+// GetAnimeFull return the anime with the included parts, or every part when none is given
+// The anime and its parts are fetched concurrently, err is only returned when the anime itself failed
+func (ths *jikanClient) GetAnimeFull(ctx context.Context, id int, include ...Part) (animeFull AnimeFull, err error) {
+	return getAnimeFull(ctx, ths, id, include...)
+}
+
+func getAnimeFull(ctx context.Context, client ContextClient, id int, include ...Part) (animeFull AnimeFull, err error) {
+	if len(include) == 0 {
+		include = AllParts
+	}
+
+	fetches := map[Part]func() error{
+		PartCharacterStaff: func() (err error) {
+			animeFull.CharacterStaff, err = client.GetAnimeCharacterStaffContext(ctx, id)
+			return
+		},
+		PartEpisodes: func() (err error) {
+			animeFull.Episodes, err = client.GetAnimeAllEpisodesContext(ctx, id, 0)
+			return
+		},
+		PartNews: func() (err error) {
+			animeFull.News, err = client.GetAnimeRelatedNewsContext(ctx, id)
+			return
+		},
+		PartPictures: func() (err error) {
+			animeFull.Pictures, err = client.GetAnimeRelatedPicturesContext(ctx, id)
+			return
+		},
+		PartVideos: func() (err error) {
+			animeFull.Videos, err = client.GetAnimeRelatedVideosContext(ctx, id)
+			return
+		},
+		PartStats: func() (err error) {
+			animeFull.Stats, err = client.GetAnimeRelatedStatsContext(ctx, id)
+			return
+		},
+		PartForum: func() (err error) {
+			animeFull.Forum, err = client.GetAnimeRelatedForumContext(ctx, id)
+			return
+		},
+		PartRecommendations: func() (err error) {
+			animeFull.Recommendations, err = client.GetAnimeRecommendationsContext(ctx, id)
+			return
+		},
+		PartReviews: func() (err error) {
+			animeFull.Reviews, err = client.GetAnimeReviewsContext(ctx, id, 0)
+			return
+		},
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		animeFull.Anime, err = client.GetAnimeContext(ctx, id)
+	}()
+
+	seen := make(map[Part]bool)
+	for _, part := range include {
+		fetch, ok := fetches[part]
+		if !ok || seen[part] {
+			continue
+		}
+
+		seen[part] = true
+		wg.Add(1)
+		go func(part Part, fetch func() error) {
+			defer wg.Done()
+
+			if partErr := fetch(); partErr != nil {
+				mu.Lock()
+				if animeFull.Errors == nil {
+					animeFull.Errors = make(map[Part]error)
+				}
+				animeFull.Errors[part] = partErr
+				mu.Unlock()
+			}
+		}(part, fetch)
+	}
+
+	wg.Wait()
+	return
+}
@@ -0,0 +1,96 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetAnimeFull(t *testing.T) {
+	Convey("Testing GetAnimeFull Method", t, func() {
+		var mu sync.Mutex
+		var paths []string
+
+		jikan := NewJikanClient(WithHTTPClient(&MockClient{
+			MockDo: func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				paths = append(paths, req.URL.Path)
+				mu.Unlock()
+
+				switch {
+				case strings.HasSuffix(req.URL.Path, "/news"):
+					return &http.Response{
+						StatusCode: 503,
+						Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+					}, nil
+				case strings.HasSuffix(req.URL.Path, "/pictures"):
+					return &http.Response{
+						StatusCode: 200,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"pictures":[{"large":"large.jpg","small":"small.jpg"}]}`))),
+					}, nil
+				case strings.HasSuffix(req.URL.Path, "/episodes"):
+					return &http.Response{
+						StatusCode: 200,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"episodes_last_page":1,"episodes":[{"episode_id":1}]}`))),
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"mal_id":1}`))),
+				}, nil
+			},
+		}))
+
+		Convey("GetAnimeFull should fetch only the included parts", func() {
+			animeFull, err := jikan.GetAnimeFull(context.Background(), 1, PartPictures, PartEpisodes, PartPictures)
+
+			sort.Strings(paths)
+			So(err, ShouldBeNil)
+			So(paths, ShouldResemble, []string{"/v3/anime/1", "/v3/anime/1/episodes", "/v3/anime/1/pictures"})
+			So(animeFull.Anime.MalID, ShouldEqual, 1)
+			So(animeFull.Pictures.Pictures, ShouldHaveLength, 1)
+			So(animeFull.Episodes.Episodes, ShouldResemble, []AnimeEpisode{AnimeEpisode{EpisodeID: 1}})
+			So(animeFull.Errors, ShouldBeNil)
+		})
+
+		Convey("GetAnimeFull should fetch every part when none is given and keep per-part errors", func() {
+			animeFull, err := jikan.GetAnimeFull(context.Background(), 1)
+
+			So(err, ShouldBeNil)
+			So(paths, ShouldHaveLength, len(AllParts)+1)
+			So(animeFull.Anime.MalID, ShouldEqual, 1)
+			So(animeFull.Pictures.Pictures, ShouldHaveLength, 1)
+			So(animeFull.Errors, ShouldHaveLength, 1)
+			So(errors.Is(animeFull.Errors[PartNews], ErrMyAnimeList), ShouldBeTrue)
+		})
+
+		Convey("GetAnimeFull should return the error of the anime itself", func() {
+			jikan := NewJikanClient(WithHTTPClient(&MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path == "/v3/anime/1" {
+						return nil, errors.New("API call failed")
+					}
+
+					return &http.Response{
+						StatusCode: 200,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"pictures":[{"large":"large.jpg"}]}`))),
+					}, nil
+				},
+			}))
+
+			animeFull, err := jikan.GetAnimeFull(context.Background(), 1, PartPictures)
+
+			So(err, ShouldBeError)
+			So(animeFull.Pictures.Pictures, ShouldHaveLength, 1)
+		})
+	})
+}
@@ -0,0 +1,176 @@
+package gojikan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MangaListItem is a struct details of a manga in genre and magazine listings
+type MangaListItem struct {
+	MalID           int             `json:"mal_id"`
+	URL             string          `json:"url"`
+	Title           string          `json:"title"`
+	ImageURL        string          `json:"image_url"`
+	Synopsis        string          `json:"synopsis"`
+	Type            string          `json:"type"`
+	PublishingStart time.Time       `json:"publishing_start"`
+	Volumes         int             `json:"volumes"`
+	Members         int             `json:"members"`
+	Genres          []AnimeResource `json:"genres"`
+	Authors         []AnimeResource `json:"authors"`
+	Score           float64         `json:"score"`
+	Serialization   []string        `json:"serialization"`
+}
+
+func pagedURL(url string, page int) string {
+	if page > 0 {
+		url = fmt.Sprintf("%s/%d", url, page)
+	}
+
+	return url
+}
+
+// AnimeGenre is a struct list of anime having the genre, maximum 100 anime per page
+type AnimeGenre struct {
+	ResponseMeta
+
+	MalURL    AnimeResource `json:"mal_url"`
+	ItemCount int           `json:"item_count"`
+	Anime     []SeasonAnime `json:"anime"`
+}
+
+// GetAnimeByGenre return anime having the genre per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetAnimeByGenre(genreID, page int) (animeGenre AnimeGenre, err error) {
+	return ths.GetAnimeByGenreContext(context.Background(), genreID, page)
+}
+
+func (ths *jikanClient) GetAnimeByGenreContext(ctx context.Context, genreID, page int) (animeGenre AnimeGenre, err error) {
+	url := pagedURL(fmt.Sprintf("%s/genre/anime/%d", ths.baseURL, genreID), page)
+
+	err = ths.get(ctx, url, &animeGenre)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaGenre is a struct list of manga having the genre, maximum 100 manga per page
+type MangaGenre struct {
+	ResponseMeta
+
+	MalURL    AnimeResource   `json:"mal_url"`
+	ItemCount int             `json:"item_count"`
+	Manga     []MangaListItem `json:"manga"`
+}
+
+// GetMangaByGenre return manga having the genre per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetMangaByGenre(genreID, page int) (mangaGenre MangaGenre, err error) {
+	return ths.GetMangaByGenreContext(context.Background(), genreID, page)
+}
+
+func (ths *jikanClient) GetMangaByGenreContext(ctx context.Context, genreID, page int) (mangaGenre MangaGenre, err error) {
+	url := pagedURL(fmt.Sprintf("%s/genre/manga/%d", ths.baseURL, genreID), page)
+
+	err = ths.get(ctx, url, &mangaGenre)
+	return
+}
+
+// ===================================================================================================================================
+
+// AnimeProducer is a struct list of anime made by the producer, maximum 100 anime per page
+type AnimeProducer struct {
+	ResponseMeta
+
+	Meta  AnimeResource `json:"meta"`
+	Anime []SeasonAnime `json:"anime"`
+}
+
+// GetAnimeByProducer return anime made by the producer per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetAnimeByProducer(producerID, page int) (animeProducer AnimeProducer, err error) {
+	return ths.GetAnimeByProducerContext(context.Background(), producerID, page)
+}
+
+func (ths *jikanClient) GetAnimeByProducerContext(ctx context.Context, producerID, page int) (animeProducer AnimeProducer, err error) {
+	url := pagedURL(fmt.Sprintf("%s/producer/%d", ths.baseURL, producerID), page)
+
+	err = ths.get(ctx, url, &animeProducer)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaMagazine is a struct list of manga serialized in the magazine, maximum 100 manga per page
+type MangaMagazine struct {
+	ResponseMeta
+
+	Meta  AnimeResource   `json:"meta"`
+	Manga []MangaListItem `json:"manga"`
+}
+
+// GetMangaByMagazine return manga serialized in the magazine per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetMangaByMagazine(magazineID, page int) (mangaMagazine MangaMagazine, err error) {
+	return ths.GetMangaByMagazineContext(context.Background(), magazineID, page)
+}
+
+func (ths *jikanClient) GetMangaByMagazineContext(ctx context.Context, magazineID, page int) (mangaMagazine MangaMagazine, err error) {
+	url := pagedURL(fmt.Sprintf("%s/magazine/%d", ths.baseURL, magazineID), page)
+
+	err = ths.get(ctx, url, &mangaMagazine)
+	return
+}
+
+// ===================================================================================================================================
+
+// UnsupportedResourceError is an error message for resource that has no listing endpoint
+const UnsupportedResourceError = "Resource does not have a listing endpoint"
+
+// ResourceListing is a struct of anime or manga listed under a genre, producer or magazine
+// ItemCount is only known for genres and is 0 for producers and magazines
+type ResourceListing struct {
+	Resource  AnimeResource
+	ItemCount int
+	Anime     []SeasonAnime
+	Manga     []MangaListItem
+}
+
+// ResolveResource return the listing of the resource from Anime or Manga like its genres,
+// producers, studios, licensors and serializations
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) ResolveResource(resource AnimeResource, page int) (listing ResourceListing, err error) {
+	return ths.ResolveResourceContext(context.Background(), resource, page)
+}
+
+func (ths *jikanClient) ResolveResourceContext(ctx context.Context, resource AnimeResource, page int) (listing ResourceListing, err error) {
+	switch {
+	case resource.Type == "anime" && strings.Contains(resource.URL, "/anime/genre/"):
+		var animeGenre AnimeGenre
+		animeGenre, err = ths.GetAnimeByGenreContext(ctx, resource.MalID, page)
+		listing = ResourceListing{Resource: animeGenre.MalURL, ItemCount: animeGenre.ItemCount, Anime: animeGenre.Anime}
+	case resource.Type == "manga" && strings.Contains(resource.URL, "/manga/genre/"):
+		var mangaGenre MangaGenre
+		mangaGenre, err = ths.GetMangaByGenreContext(ctx, resource.MalID, page)
+		listing = ResourceListing{Resource: mangaGenre.MalURL, ItemCount: mangaGenre.ItemCount, Manga: mangaGenre.Manga}
+	case resource.Type == "anime" && strings.Contains(resource.URL, "/anime/producer/"):
+		var animeProducer AnimeProducer
+		animeProducer, err = ths.GetAnimeByProducerContext(ctx, resource.MalID, page)
+		listing = ResourceListing{Resource: animeProducer.Meta, Anime: animeProducer.Anime}
+	case resource.Type == "manga" && strings.Contains(resource.URL, "/manga/magazine/"):
+		var mangaMagazine MangaMagazine
+		mangaMagazine, err = ths.GetMangaByMagazineContext(ctx, resource.MalID, page)
+		listing = ResourceListing{Resource: mangaMagazine.Meta, Manga: mangaMagazine.Manga}
+	default:
+		err = errors.New(UnsupportedResourceError)
+	}
+
+	if err != nil {
+		listing = ResourceListing{}
+	}
+
+	return
+}
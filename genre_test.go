@@ -0,0 +1,512 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGenreEndpoints(t *testing.T) {
+	Convey("Testing Genre, Producer and Magazine Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		Convey("Testing GetAnimeByGenre Method", func() {
+			expectedAnimeGenre := AnimeGenre{
+				MalURL: AnimeResource{
+					MalID: 1,
+					Type:  "anime",
+					Name:  "Action Anime",
+					URL:   "https://myanimelist.net/anime/genre/1/Action",
+				},
+				ItemCount: 3500,
+				Anime: []SeasonAnime{
+					SeasonAnime{
+						MalID:    1,
+						URL:      "https://myanimelist.net/anime/1/Cowboy_Bebop",
+						Title:    "Cowboy Bebop",
+						Type:     "TV",
+						Episodes: 26,
+						Score:    8.78,
+					},
+				},
+			}
+
+			expectedAnimeGenreBytes, err := json.Marshal(expectedAnimeGenre)
+			So(err, ShouldBeNil)
+
+			Convey("GetAnimeByGenre should return an AnimeGenre given valid genre ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedAnimeGenreBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/genre/anime/1/2")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeGenre, err := jikan.GetAnimeByGenre(1, 2)
+
+				So(animeGenre, ShouldResemble, expectedAnimeGenre)
+				So(animeGenre.ItemCount, ShouldEqual, 3500)
+				So(animeGenre.Anime[0].MalID, ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetAnimeByGenre should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				animeGenre, err := jikan.GetAnimeByGenre(1, 2)
+
+				So(animeGenre, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetAnimeByGenre should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				animeGenre, err := jikan.GetAnimeByGenre(0, 0)
+
+				So(animeGenre, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetAnimeByGenre should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeGenre, err := jikan.GetAnimeByGenre(0, 0)
+
+				So(animeGenre, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaByGenre Method", func() {
+			expectedMangaGenre := MangaGenre{
+				MalURL: AnimeResource{
+					MalID: 7,
+					Type:  "manga",
+					Name:  "Mystery Manga",
+					URL:   "https://myanimelist.net/manga/genre/7/Mystery",
+				},
+				ItemCount: 2000,
+				Manga: []MangaListItem{
+					MangaListItem{
+						MalID:         1,
+						URL:           "https://myanimelist.net/manga/1/Monster",
+						Title:         "Monster",
+						Type:          "Manga",
+						Volumes:       18,
+						Score:         9.11,
+						Serialization: []string{"Big Comic Original"},
+					},
+				},
+			}
+
+			expectedMangaGenreBytes, err := json.Marshal(expectedMangaGenre)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaByGenre should return a MangaGenre given valid genre ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaGenreBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/genre/manga/7")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaGenre, err := jikan.GetMangaByGenre(7, 0)
+
+				So(mangaGenre, ShouldResemble, expectedMangaGenre)
+				So(mangaGenre.ItemCount, ShouldEqual, 2000)
+				So(mangaGenre.Manga[0].Serialization[0], ShouldEqual, "Big Comic Original")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaByGenre should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaGenre, err := jikan.GetMangaByGenre(7, 0)
+
+				So(mangaGenre, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaByGenre should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaGenre, err := jikan.GetMangaByGenre(0, 0)
+
+				So(mangaGenre, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaByGenre should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaGenre, err := jikan.GetMangaByGenre(0, 0)
+
+				So(mangaGenre, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetAnimeByProducer Method", func() {
+			expectedAnimeProducer := AnimeProducer{
+				Meta: AnimeResource{
+					MalID: 14,
+					Type:  "anime",
+					Name:  "Sunrise",
+					URL:   "https://myanimelist.net/anime/producer/14/Sunrise",
+				},
+				Anime: []SeasonAnime{
+					SeasonAnime{
+						MalID:    1,
+						URL:      "https://myanimelist.net/anime/1/Cowboy_Bebop",
+						Title:    "Cowboy Bebop",
+						Type:     "TV",
+						Episodes: 26,
+						Score:    8.78,
+					},
+				},
+			}
+
+			expectedAnimeProducerBytes, err := json.Marshal(expectedAnimeProducer)
+			So(err, ShouldBeNil)
+
+			Convey("GetAnimeByProducer should return an AnimeProducer given valid producer ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedAnimeProducerBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/producer/14")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeProducer, err := jikan.GetAnimeByProducer(14, 0)
+
+				So(animeProducer, ShouldResemble, expectedAnimeProducer)
+				So(animeProducer.Meta.Name, ShouldEqual, "Sunrise")
+				So(len(animeProducer.Anime), ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetAnimeByProducer should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				animeProducer, err := jikan.GetAnimeByProducer(14, 0)
+
+				So(animeProducer, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetAnimeByProducer should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				animeProducer, err := jikan.GetAnimeByProducer(0, 0)
+
+				So(animeProducer, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetAnimeByProducer should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeProducer, err := jikan.GetAnimeByProducer(0, 0)
+
+				So(animeProducer, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaByMagazine Method", func() {
+			expectedMangaMagazine := MangaMagazine{
+				Meta: AnimeResource{
+					MalID: 1,
+					Type:  "manga",
+					Name:  "Big Comic Original",
+					URL:   "https://myanimelist.net/manga/magazine/1/Big_Comic_Original",
+				},
+				Manga: []MangaListItem{
+					MangaListItem{
+						MalID:         1,
+						URL:           "https://myanimelist.net/manga/1/Monster",
+						Title:         "Monster",
+						Type:          "Manga",
+						Volumes:       18,
+						Score:         9.11,
+						Serialization: []string{"Big Comic Original"},
+					},
+				},
+			}
+
+			expectedMangaMagazineBytes, err := json.Marshal(expectedMangaMagazine)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaByMagazine should return a MangaMagazine given valid magazine ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaMagazineBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/magazine/1/3")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaMagazine, err := jikan.GetMangaByMagazine(1, 3)
+
+				So(mangaMagazine, ShouldResemble, expectedMangaMagazine)
+				So(mangaMagazine.Meta.Name, ShouldEqual, "Big Comic Original")
+				So(len(mangaMagazine.Manga), ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaByMagazine should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaMagazine, err := jikan.GetMangaByMagazine(1, 3)
+
+				So(mangaMagazine, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaByMagazine should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaMagazine, err := jikan.GetMangaByMagazine(0, 0)
+
+				So(mangaMagazine, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaByMagazine should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaMagazine, err := jikan.GetMangaByMagazine(0, 0)
+
+				So(mangaMagazine, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestResolveResource(t *testing.T) {
+	Convey("Testing ResolveResource Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		mockPath := func(path, body string) {
+			jikan.client = &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					So(req.URL.Path, ShouldEndWith, path)
+
+					return &http.Response{
+						StatusCode: 200,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				},
+			}
+		}
+
+		Convey("ResolveResource should list anime given anime genre", func() {
+			mockPath("/genre/anime/1", `{"mal_url":{"mal_id":1,"name":"Action Anime"},"item_count":10,"anime":[{"mal_id":1}]}`)
+
+			listing, err := jikan.ResolveResource(AnimeResource{
+				MalID: 1,
+				Type:  "anime",
+				Name:  "Action",
+				URL:   "https://myanimelist.net/anime/genre/1/Action",
+			}, 0)
+
+			So(err, ShouldBeNil)
+			So(listing.Resource.Name, ShouldEqual, "Action Anime")
+			So(listing.ItemCount, ShouldEqual, 10)
+			So(listing.Anime[0].MalID, ShouldEqual, 1)
+			So(listing.Manga, ShouldBeEmpty)
+		})
+
+		Convey("ResolveResource should list manga given manga genre", func() {
+			mockPath("/genre/manga/7/2", `{"mal_url":{"mal_id":7},"item_count":5,"manga":[{"mal_id":1}]}`)
+
+			listing, err := jikan.ResolveResource(AnimeResource{
+				MalID: 7,
+				Type:  "manga",
+				URL:   "https://myanimelist.net/manga/genre/7/Mystery",
+			}, 2)
+
+			So(err, ShouldBeNil)
+			So(listing.ItemCount, ShouldEqual, 5)
+			So(listing.Manga[0].MalID, ShouldEqual, 1)
+		})
+
+		Convey("ResolveResource should list anime given producer or studio", func() {
+			mockPath("/producer/14", `{"meta":{"mal_id":14,"name":"Sunrise"},"anime":[{"mal_id":1}]}`)
+
+			listing, err := jikan.ResolveResource(AnimeResource{
+				MalID: 14,
+				Type:  "anime",
+				URL:   "https://myanimelist.net/anime/producer/14/Sunrise",
+			}, 0)
+
+			So(err, ShouldBeNil)
+			So(listing.Resource.Name, ShouldEqual, "Sunrise")
+			So(listing.Anime[0].MalID, ShouldEqual, 1)
+		})
+
+		Convey("ResolveResource should list manga given magazine", func() {
+			mockPath("/magazine/1", `{"meta":{"mal_id":1,"name":"Big Comic Original"},"manga":[{"mal_id":1}]}`)
+
+			listing, err := jikan.ResolveResource(AnimeResource{
+				MalID: 1,
+				Type:  "manga",
+				URL:   "https://myanimelist.net/manga/magazine/1/Big_Comic_Original",
+			}, 0)
+
+			So(err, ShouldBeNil)
+			So(listing.Manga[0].MalID, ShouldEqual, 1)
+		})
+
+		Convey("ResolveResource should return UnsupportedResourceError given resource without listing", func() {
+			listing, err := jikan.ResolveResource(AnimeResource{
+				MalID: 1,
+				Type:  "people",
+				URL:   "https://myanimelist.net/people/1867/Naoki_Urasawa",
+			}, 0)
+
+			So(listing, ShouldBeZeroValue)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, UnsupportedResourceError)
+		})
+
+		Convey("ResolveResource should return error when the API call failed", func() {
+			jikan.client = &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 404,
+						Body:       nil,
+					}, nil
+				},
+			}
+
+			listing, err := jikan.ResolveResource(AnimeResource{
+				MalID: 1,
+				Type:  "anime",
+				URL:   "https://myanimelist.net/anime/genre/1/Action",
+			}, 0)
+
+			So(listing, ShouldBeZeroValue)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+		})
+	})
+}
@@ -0,0 +1,192 @@
+package gojikan
+
+import (
+	"context"
+	"errors"
+)
+
+// reviewsPerPage is the number of reviews in a full page of Jikan's reviews endpoint
+const reviewsPerPage = 20
+
+// PageFunc is a function that fetches the page, starting from 1, and return its items
+// and whether it is the last page
+type PageFunc func(ctx context.Context, page int) (items []interface{}, last bool, err error)
+
+// Pager is an iterator over the items of every page of a paged endpoint
+// Pages are fetched lazily through the client, so they respect its rate limiter
+type Pager struct {
+	fetch PageFunc
+	page  int
+	items []interface{}
+	index int
+	value interface{}
+	done  bool
+	err   error
+}
+
+// NewPager return a Pager that fetches its pages with fetch
+func NewPager(fetch PageFunc) *Pager {
+	return &Pager{fetch: fetch}
+}
+
+// Next advances to the next item, fetching the next page when needed
+// It return false when there is no item left or fetching a page failed
+func (ths *Pager) Next(ctx context.Context) bool {
+	for ths.index >= len(ths.items) {
+		if ths.done || ths.err != nil {
+			return false
+		}
+
+		items, last, err := ths.fetch(ctx, ths.page+1)
+		if err != nil {
+			ths.err = err
+			return false
+		}
+
+		ths.page++
+		ths.items = items
+		ths.index = 0
+		ths.done = last || len(items) == 0
+	}
+
+	ths.value = ths.items[ths.index]
+	ths.index++
+
+	return true
+}
+
+// Value return the current item
+func (ths *Pager) Value() interface{} {
+	return ths.value
+}
+
+// Err return the error that stopped the iteration
+func (ths *Pager) Err() error {
+	return ths.err
+}
+
+// Page return the number of the last fetched page
+func (ths *Pager) Page() int {
+	return ths.page
+}
+
+// CollectAll fetches every remaining page and return all of their items
+func (ths *Pager) CollectAll(ctx context.Context) (items []interface{}, err error) {
+	for ths.Next(ctx) {
+		items = append(items, ths.Value())
+	}
+
+	err = ths.Err()
+	return
+}
+
+// ===================================================================================================================================
+
+// EpisodeIterator is an iterator over every episode of the anime
+type EpisodeIterator struct {
+	pager *Pager
+}
+
+// NewEpisodeIterator return an EpisodeIterator of the anime with the id
+// It stops on the anime's episodes last page
+func NewEpisodeIterator(client ContextClient, id int) *EpisodeIterator {
+	return &EpisodeIterator{
+		pager: NewPager(func(ctx context.Context, page int) ([]interface{}, bool, error) {
+			animeEpisodes, err := client.GetAnimeAllEpisodesContext(ctx, id, page)
+			if err != nil {
+				return nil, false, err
+			}
+
+			items := make([]interface{}, len(animeEpisodes.Episodes))
+			for i, episode := range animeEpisodes.Episodes {
+				items[i] = episode
+			}
+
+			return items, page >= animeEpisodes.EpisodesLastPage, nil
+		}),
+	}
+}
+
+// Next advances to the next episode
+func (ths *EpisodeIterator) Next(ctx context.Context) bool {
+	return ths.pager.Next(ctx)
+}
+
+// Value return the current episode
+func (ths *EpisodeIterator) Value() AnimeEpisode {
+	episode, _ := ths.pager.Value().(AnimeEpisode)
+	return episode
+}
+
+// Err return the error that stopped the iteration
+func (ths *EpisodeIterator) Err() error {
+	return ths.pager.Err()
+}
+
+// CollectAll fetches every remaining page and return all of their episodes
+func (ths *EpisodeIterator) CollectAll(ctx context.Context) (episodes []AnimeEpisode, err error) {
+	for ths.Next(ctx) {
+		episodes = append(episodes, ths.Value())
+	}
+
+	err = ths.Err()
+	return
+}
+
+// ===================================================================================================================================
+
+// ReviewIterator is an iterator over every review of the anime
+type ReviewIterator struct {
+	pager *Pager
+}
+
+// NewReviewIterator return a ReviewIterator of the anime with the id
+// Jikan does not tell the last page of reviews, so it stops on a page that is not full
+// or a page that does not exist
+func NewReviewIterator(client ContextClient, id int) *ReviewIterator {
+	return &ReviewIterator{
+		pager: NewPager(func(ctx context.Context, page int) ([]interface{}, bool, error) {
+			animeReviews, err := client.GetAnimeReviewsContext(ctx, id, page)
+			if page > 1 && errors.Is(err, ErrNotFound) {
+				return nil, true, nil
+			}
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			items := make([]interface{}, len(animeReviews.Reviews))
+			for i, review := range animeReviews.Reviews {
+				items[i] = review
+			}
+
+			return items, len(items) < reviewsPerPage, nil
+		}),
+	}
+}
+
+// Next advances to the next review
+func (ths *ReviewIterator) Next(ctx context.Context) bool {
+	return ths.pager.Next(ctx)
+}
+
+// Value return the current review
+func (ths *ReviewIterator) Value() AnimeReview {
+	review, _ := ths.pager.Value().(AnimeReview)
+	return review
+}
+
+// Err return the error that stopped the iteration
+func (ths *ReviewIterator) Err() error {
+	return ths.pager.Err()
+}
+
+// CollectAll fetches every remaining page and return all of their reviews
+func (ths *ReviewIterator) CollectAll(ctx context.Context) (reviews []AnimeReview, err error) {
+	for ths.Next(ctx) {
+		reviews = append(reviews, ths.Value())
+	}
+
+	err = ths.Err()
+	return
+}
@@ -0,0 +1,150 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIterators(t *testing.T) {
+	Convey("Testing Iterators", t, func() {
+		var urls []string
+		respond := func(responses map[string]string) *MockClient {
+			return &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					url := req.URL.String()
+					urls = append(urls, url)
+
+					for suffix, body := range responses {
+						if strings.HasSuffix(url, suffix) {
+							return &http.Response{
+								StatusCode: 200,
+								Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+							}, nil
+						}
+					}
+
+					return &http.Response{
+						StatusCode: 404,
+						Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+					}, nil
+				},
+			}
+		}
+
+		Convey("Pager should iterate every item until the last page", func() {
+			pager := NewPager(func(ctx context.Context, page int) ([]interface{}, bool, error) {
+				return []interface{}{page * 10, page*10 + 1}, page == 3, nil
+			})
+
+			items, err := pager.CollectAll(context.Background())
+
+			So(err, ShouldBeNil)
+			So(items, ShouldResemble, []interface{}{10, 11, 20, 21, 30, 31})
+			So(pager.Page(), ShouldEqual, 3)
+			So(pager.Next(context.Background()), ShouldBeFalse)
+		})
+
+		Convey("Pager should stop on an empty page and on an error", func() {
+			pager := NewPager(func(ctx context.Context, page int) ([]interface{}, bool, error) {
+				if page == 2 {
+					return nil, false, nil
+				}
+				return []interface{}{page}, false, nil
+			})
+
+			items, err := pager.CollectAll(context.Background())
+
+			So(err, ShouldBeNil)
+			So(items, ShouldResemble, []interface{}{1})
+
+			pager = NewPager(func(ctx context.Context, page int) ([]interface{}, bool, error) {
+				if page == 2 {
+					return nil, false, errors.New("API call failed")
+				}
+				return []interface{}{page}, false, nil
+			})
+
+			So(pager.Next(context.Background()), ShouldBeTrue)
+			So(pager.Value(), ShouldEqual, 1)
+			So(pager.Next(context.Background()), ShouldBeFalse)
+			So(pager.Err(), ShouldBeError)
+			So(pager.Page(), ShouldEqual, 1)
+		})
+
+		Convey("EpisodeIterator should stop on the episodes last page", func() {
+			jikan := NewJikanClient(WithHTTPClient(respond(map[string]string{
+				"/anime/1/episodes/1": `{"episodes_last_page":2,"episodes":[{"episode_id":1},{"episode_id":2}]}`,
+				"/anime/1/episodes/2": `{"episodes_last_page":2,"episodes":[{"episode_id":3}]}`,
+			})))
+
+			episodes, err := NewEpisodeIterator(jikan, 1).CollectAll(context.Background())
+
+			So(err, ShouldBeNil)
+			So(episodes, ShouldResemble, []AnimeEpisode{
+				AnimeEpisode{EpisodeID: 1},
+				AnimeEpisode{EpisodeID: 2},
+				AnimeEpisode{EpisodeID: 3},
+			})
+			So(urls, ShouldHaveLength, 2)
+		})
+
+		Convey("EpisodeIterator should return the error of the first page", func() {
+			jikan := NewJikanClient(WithHTTPClient(respond(nil)))
+			iterator := NewEpisodeIterator(jikan, 1)
+
+			So(iterator.Next(context.Background()), ShouldBeFalse)
+			So(errors.Is(iterator.Err(), ErrNotFound), ShouldBeTrue)
+		})
+
+		Convey("ReviewIterator should stop on a page that is not full or does not exist", func() {
+			full := make([]string, reviewsPerPage)
+			for i := range full {
+				full[i] = fmt.Sprintf(`{"mal_id":%d}`, i+1)
+			}
+
+			jikan := NewJikanClient(WithHTTPClient(respond(map[string]string{
+				"/anime/1/reviews/1": `{"reviews":[` + strings.Join(full, ",") + `]}`,
+				"/anime/1/reviews/2": `{"reviews":[{"mal_id":21}]}`,
+				"/anime/2/reviews/1": `{"reviews":[` + strings.Join(full, ",") + `]}`,
+			})))
+
+			iterator := NewReviewIterator(jikan, 1)
+			reviews, err := iterator.CollectAll(context.Background())
+
+			So(err, ShouldBeNil)
+			So(reviews, ShouldHaveLength, reviewsPerPage+1)
+			So(reviews[reviewsPerPage].MalID, ShouldEqual, 21)
+
+			urls = nil
+			reviews, err = NewReviewIterator(jikan, 2).CollectAll(context.Background())
+
+			So(err, ShouldBeNil)
+			So(reviews, ShouldHaveLength, reviewsPerPage)
+			So(urls, ShouldHaveLength, 2)
+		})
+
+		Convey("Iterators should fetch their pages through the client's rate limiter", func() {
+			jikan := NewJikanClient(
+				WithRateLimiter(NewRateLimiter(RateLimitFailFast, RateLimit{Requests: 1, Per: time.Hour})),
+				WithHTTPClient(respond(map[string]string{
+					"/anime/1/episodes/1": `{"episodes_last_page":2,"episodes":[{"episode_id":1}]}`,
+					"/anime/1/episodes/2": `{"episodes_last_page":2,"episodes":[{"episode_id":2}]}`,
+				})),
+			)
+
+			episodes, err := NewEpisodeIterator(jikan, 1).CollectAll(context.Background())
+
+			So(errors.Is(err, ErrClientRateLimited), ShouldBeTrue)
+			So(episodes, ShouldResemble, []AnimeEpisode{AnimeEpisode{EpisodeID: 1}})
+		})
+	})
+}
@@ -0,0 +1,297 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Manga is a struct of manga details from MyAnimeList
+type Manga struct {
+	ResponseMeta
+
+	MalID          int             `json:"mal_id"`
+	URL            string          `json:"url"`
+	Title          string          `json:"title"`
+	TitleEnglish   string          `json:"title_english"`
+	TitleSynonyms  []string        `json:"title_synonyms"`
+	TitleJapanese  string          `json:"title_japanese"`
+	Status         string          `json:"status"`
+	ImageURL       string          `json:"image_url"`
+	Type           string          `json:"type"`
+	Volumes        int             `json:"volumes"`
+	Chapters       int             `json:"chapters"`
+	Publishing     bool            `json:"publishing"`
+	Published      AiredTimeline   `json:"published"`
+	Rank           int             `json:"rank"`
+	Score          float64         `json:"score"`
+	ScoredBy       int             `json:"scored_by"`
+	Popularity     int             `json:"popularity"`
+	Members        int             `json:"members"`
+	Favorites      int             `json:"favorites"`
+	Synopsis       string          `json:"synopsis"`
+	Background     string          `json:"background"`
+	Related        RelatedAnime    `json:"related"`
+	Genres         []AnimeResource `json:"genres"`
+	Authors        []AnimeResource `json:"authors"`
+	Serializations []AnimeResource `json:"serializations"`
+}
+
+func (ths *jikanClient) GetManga(id int) (manga Manga, err error) {
+	return ths.GetMangaContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaContext(ctx context.Context, id int) (manga Manga, err error) {
+	url := fmt.Sprintf("%s/manga/%d", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &manga)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaCharacters is a struct of characters appearing in the manga
+type MangaCharacters struct {
+	ResponseMeta
+
+	Characters []MangaCharacter `json:"characters"`
+}
+
+// MangaCharacter is a struct of character's details in the manga
+type MangaCharacter struct {
+	MalID    int    `json:"mal_id"`
+	URL      string `json:"url"`
+	ImageURL string `json:"image_url"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+}
+
+func (ths *jikanClient) GetMangaCharacters(id int) (mangaCharacters MangaCharacters, err error) {
+	return ths.GetMangaCharactersContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaCharactersContext(ctx context.Context, id int) (mangaCharacters MangaCharacters, err error) {
+	url := fmt.Sprintf("%s/manga/%d/characters", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaCharacters)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaNews is a struct of related news articles of the manga
+type MangaNews struct {
+	ResponseMeta
+
+	Articles []AnimeNewsArticle `json:"articles"`
+}
+
+func (ths *jikanClient) GetMangaNews(id int) (mangaNews MangaNews, err error) {
+	return ths.GetMangaNewsContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaNewsContext(ctx context.Context, id int) (mangaNews MangaNews, err error) {
+	url := fmt.Sprintf("%s/manga/%d/news", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaNews)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaPictures is a struct of related pictures of the manga
+type MangaPictures struct {
+	ResponseMeta
+
+	Pictures []AnimePicture `json:"pictures"`
+}
+
+func (ths *jikanClient) GetMangaPictures(id int) (mangaPictures MangaPictures, err error) {
+	return ths.GetMangaPicturesContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaPicturesContext(ctx context.Context, id int) (mangaPictures MangaPictures, err error) {
+	url := fmt.Sprintf("%s/manga/%d/pictures", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaPictures)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaStats is a struct of related stats of the manga
+type MangaStats struct {
+	ResponseMeta
+
+	Reading    int         `json:"reading"`
+	Completed  int         `json:"completed"`
+	OnHold     int         `json:"on_hold"`
+	Dropped    int         `json:"dropped"`
+	PlanToRead int         `json:"plan_to_read"`
+	Total      int         `json:"total"`
+	Scores     AnimeScores `json:"scores"`
+}
+
+func (ths *jikanClient) GetMangaStats(id int) (mangaStats MangaStats, err error) {
+	return ths.GetMangaStatsContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaStatsContext(ctx context.Context, id int) (mangaStats MangaStats, err error) {
+	url := fmt.Sprintf("%s/manga/%d/stats", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaStats)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaForum is a struct of related forum topics of the manga
+type MangaForum struct {
+	ResponseMeta
+
+	Topics []AnimeForumTopic `json:"topics"`
+}
+
+func (ths *jikanClient) GetMangaForum(id int) (mangaForum MangaForum, err error) {
+	return ths.GetMangaForumContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaForumContext(ctx context.Context, id int) (mangaForum MangaForum, err error) {
+	url := fmt.Sprintf("%s/manga/%d/forum", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaForum)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaMoreInfo is a struct of additional information of the manga
+type MangaMoreInfo struct {
+	ResponseMeta
+
+	MoreInfo string `json:"moreinfo"`
+}
+
+func (ths *jikanClient) GetMangaMoreInfo(id int) (mangaMoreInfo MangaMoreInfo, err error) {
+	return ths.GetMangaMoreInfoContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaMoreInfoContext(ctx context.Context, id int) (mangaMoreInfo MangaMoreInfo, err error) {
+	url := fmt.Sprintf("%s/manga/%d/moreinfo", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaMoreInfo)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaRecommendations is a struct list of recommendations for the related manga
+type MangaRecommendations struct {
+	ResponseMeta
+
+	Recommendations []AnimeRecommendation `json:"recommendations"`
+}
+
+func (ths *jikanClient) GetMangaRecommendations(id int) (mangaRecommendations MangaRecommendations, err error) {
+	return ths.GetMangaRecommendationsContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetMangaRecommendationsContext(ctx context.Context, id int) (mangaRecommendations MangaRecommendations, err error) {
+	url := fmt.Sprintf("%s/manga/%d/recommendations", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &mangaRecommendations)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaReviews is a struct list of manga reviews by user
+type MangaReviews struct {
+	ResponseMeta
+
+	Reviews []MangaReview `json:"reviews"`
+}
+
+// MangaReviewScore is a struct details of score of manga reviews
+type MangaReviewScore struct {
+	Overall   int `json:"overall"`
+	Story     int `json:"story"`
+	Art       int `json:"art"`
+	Character int `json:"character"`
+	Enjoyment int `json:"enjoyment"`
+}
+
+// MangaReviewer is a struct details of reviewer of manga reviews
+type MangaReviewer struct {
+	URL          string           `json:"url"`
+	ImageURL     string           `json:"image_url"`
+	Username     string           `json:"username"`
+	ChaptersRead int              `json:"chapters_read"`
+	Scores       MangaReviewScore `json:"scores"`
+}
+
+// MangaReview is a struct details of manga's review
+type MangaReview struct {
+	MalID        int           `json:"mal_id"`
+	URL          string        `json:"url"`
+	Type         interface{}   `json:"type"`
+	HelpfulCount int           `json:"helpful_count"`
+	Date         time.Time     `json:"date"`
+	Reviewer     MangaReviewer `json:"reviewer"`
+	Content      string        `json:"content"`
+}
+
+// GetMangaReviews return manga's reviews per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetMangaReviews(id, page int) (mangaReviews MangaReviews, err error) {
+	return ths.GetMangaReviewsContext(context.Background(), id, page)
+}
+
+func (ths *jikanClient) GetMangaReviewsContext(ctx context.Context, id, page int) (mangaReviews MangaReviews, err error) {
+	url := fmt.Sprintf("%s/manga/%d/reviews", ths.baseURL, id)
+	if page > 0 {
+		url = fmt.Sprintf("%s/%d", url, page)
+	}
+
+	err = ths.get(ctx, url, &mangaReviews)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaUserUpdates is a struct list of latest list updates by users for the manga
+type MangaUserUpdates struct {
+	ResponseMeta
+
+	Users []MangaUserUpdate `json:"users"`
+}
+
+// MangaUserUpdate is a struct details of a user's list update for the manga
+type MangaUserUpdate struct {
+	Username      string    `json:"username"`
+	URL           string    `json:"url"`
+	ImageURL      string    `json:"image_url"`
+	Score         int       `json:"score"`
+	Status        string    `json:"status"`
+	VolumesRead   int       `json:"volumes_read"`
+	VolumesTotal  int       `json:"volumes_total"`
+	ChaptersRead  int       `json:"chapters_read"`
+	ChaptersTotal int       `json:"chapters_total"`
+	Date          time.Time `json:"date"`
+}
+
+// GetMangaUserUpdates return latest users' list updates of the manga per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetMangaUserUpdates(id, page int) (mangaUserUpdates MangaUserUpdates, err error) {
+	return ths.GetMangaUserUpdatesContext(context.Background(), id, page)
+}
+
+func (ths *jikanClient) GetMangaUserUpdatesContext(ctx context.Context, id, page int) (mangaUserUpdates MangaUserUpdates, err error) {
+	url := fmt.Sprintf("%s/manga/%d/userupdates", ths.baseURL, id)
+	if page > 0 {
+		url = fmt.Sprintf("%s/%d", url, page)
+	}
+
+	err = ths.get(ctx, url, &mangaUserUpdates)
+	return
+}
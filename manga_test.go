@@ -0,0 +1,934 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMangaEndpoints(t *testing.T) {
+	Convey("Testing Manga Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+		mangaID := 1
+
+		Convey("Testing GetManga Method", func() {
+			expectedManga := Manga{
+				MalID:         mangaID,
+				URL:           "https://myanimelist.net/manga/1/Monster",
+				Title:         "Monster",
+				TitleEnglish:  "Monster",
+				TitleJapanese: "MONSTER",
+				Status:        "Finished",
+				ImageURL:      "https://cdn.myanimelist.net/images/manga/3/54525.jpg",
+				Type:          "Manga",
+				Volumes:       18,
+				Chapters:      162,
+				Publishing:    false,
+				Published:     AiredTimeline{},
+				Genres: []AnimeResource{
+					AnimeResource{
+						MalID: 7,
+						Type:  "manga",
+						Name:  "Mystery",
+						URL:   "https://myanimelist.net/manga/genre/7/Mystery",
+					},
+				},
+			}
+
+			expectedMangaBytes, err := json.Marshal(expectedManga)
+			So(err, ShouldBeNil)
+
+			Convey("GetManga should return a Manga given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				manga, err := jikan.GetManga(mangaID)
+
+				So(manga, ShouldResemble, expectedManga)
+				So(manga.MalID, ShouldEqual, mangaID)
+				So(manga.Title, ShouldEqual, expectedManga.Title)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetManga should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				manga, err := jikan.GetManga(mangaID)
+
+				So(manga, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetManga should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				manga, err := jikan.GetManga(0)
+
+				So(manga, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetManga should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				manga, err := jikan.GetManga(0)
+
+				So(manga, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaCharacters Method", func() {
+			expectedMangaCharacters := MangaCharacters{
+				Characters: []MangaCharacter{
+					MangaCharacter{
+						MalID:    1452,
+						URL:      "https://myanimelist.net/character/1452/Kenzou_Tenma",
+						ImageURL: "https://cdn.myanimelist.net/images/characters/6/257541.jpg",
+						Name:     "Tenma, Kenzou",
+						Role:     "Main",
+					},
+				},
+			}
+
+			expectedMangaCharactersBytes, err := json.Marshal(expectedMangaCharacters)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaCharacters should return a MangaCharacters given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaCharactersBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaCharacters, err := jikan.GetMangaCharacters(mangaID)
+
+				So(mangaCharacters, ShouldResemble, expectedMangaCharacters)
+				So(len(mangaCharacters.Characters), ShouldEqual, 1)
+				So(mangaCharacters.Characters[0].Role, ShouldEqual, "Main")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaCharacters should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaCharacters, err := jikan.GetMangaCharacters(mangaID)
+
+				So(mangaCharacters, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaCharacters should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaCharacters, err := jikan.GetMangaCharacters(0)
+
+				So(mangaCharacters, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaCharacters should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaCharacters, err := jikan.GetMangaCharacters(0)
+
+				So(mangaCharacters, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaNews Method", func() {
+			expectedMangaNews := MangaNews{
+				Articles: []AnimeNewsArticle{
+					AnimeNewsArticle{
+						URL:        "https://myanimelist.net/news/1",
+						Title:      "Monster Live-Action Series in Development",
+						AuthorName: "Snow",
+						AuthorURL:  "https://myanimelist.net/profile/Snow",
+						ForumURL:   "https://myanimelist.net/forum/?topicid=1",
+						Comments:   10,
+						Intro:      "This is the first news",
+					},
+				},
+			}
+
+			expectedMangaNewsBytes, err := json.Marshal(expectedMangaNews)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaNews should return a MangaNews given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaNewsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaNews, err := jikan.GetMangaNews(mangaID)
+
+				So(mangaNews, ShouldResemble, expectedMangaNews)
+				So(len(mangaNews.Articles), ShouldEqual, 1)
+				So(mangaNews.Articles[0].Comments, ShouldEqual, 10)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaNews should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaNews, err := jikan.GetMangaNews(mangaID)
+
+				So(mangaNews, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaNews should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaNews, err := jikan.GetMangaNews(0)
+
+				So(mangaNews, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaNews should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaNews, err := jikan.GetMangaNews(0)
+
+				So(mangaNews, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaPictures Method", func() {
+			expectedMangaPictures := MangaPictures{
+				Pictures: []AnimePicture{
+					AnimePicture{
+						Large: "https://cdn.myanimelist.net/images/manga/3/54525l.jpg",
+						Small: "https://cdn.myanimelist.net/images/manga/3/54525.jpg",
+					},
+				},
+			}
+
+			expectedMangaPicturesBytes, err := json.Marshal(expectedMangaPictures)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaPictures should return a MangaPictures given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaPicturesBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaPictures, err := jikan.GetMangaPictures(mangaID)
+
+				So(mangaPictures, ShouldResemble, expectedMangaPictures)
+				So(len(mangaPictures.Pictures), ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaPictures should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaPictures, err := jikan.GetMangaPictures(mangaID)
+
+				So(mangaPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaPictures should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaPictures, err := jikan.GetMangaPictures(0)
+
+				So(mangaPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaPictures should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaPictures, err := jikan.GetMangaPictures(0)
+
+				So(mangaPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaStats Method", func() {
+			expectedMangaStats := MangaStats{
+				Reading:    20000,
+				Completed:  50000,
+				OnHold:     5000,
+				Dropped:    1000,
+				PlanToRead: 30000,
+				Total:      106000,
+				Scores: AnimeScores{
+					Ten: AnimeScoreValue{
+						Votes:      30000,
+						Percentage: 45.5,
+					},
+				},
+			}
+
+			expectedMangaStatsBytes, err := json.Marshal(expectedMangaStats)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaStats should return a MangaStats given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaStatsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaStats, err := jikan.GetMangaStats(mangaID)
+
+				So(mangaStats, ShouldResemble, expectedMangaStats)
+				So(mangaStats.Total, ShouldEqual, 106000)
+				So(mangaStats.Scores.Ten.Votes, ShouldEqual, 30000)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaStats should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaStats, err := jikan.GetMangaStats(mangaID)
+
+				So(mangaStats, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaStats should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaStats, err := jikan.GetMangaStats(0)
+
+				So(mangaStats, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaStats should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaStats, err := jikan.GetMangaStats(0)
+
+				So(mangaStats, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaForum Method", func() {
+			expectedMangaForum := MangaForum{
+				Topics: []AnimeForumTopic{
+					AnimeForumTopic{
+						TopicID:    1,
+						URL:        "https://myanimelist.net/forum/?topicid=1",
+						Title:      "Monster Chapter 1 Discussion",
+						AuthorName: "Snow",
+						AuthorURL:  "https://myanimelist.net/profile/Snow",
+						Replies:    42,
+					},
+				},
+			}
+
+			expectedMangaForumBytes, err := json.Marshal(expectedMangaForum)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaForum should return a MangaForum given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaForumBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaForum, err := jikan.GetMangaForum(mangaID)
+
+				So(mangaForum, ShouldResemble, expectedMangaForum)
+				So(len(mangaForum.Topics), ShouldEqual, 1)
+				So(mangaForum.Topics[0].Replies, ShouldEqual, 42)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaForum should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaForum, err := jikan.GetMangaForum(mangaID)
+
+				So(mangaForum, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaForum should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaForum, err := jikan.GetMangaForum(0)
+
+				So(mangaForum, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaForum should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaForum, err := jikan.GetMangaForum(0)
+
+				So(mangaForum, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaMoreInfo Method", func() {
+			expectedMangaMoreInfo := MangaMoreInfo{
+				MoreInfo: "Won the 46th Shogakukan Manga Award.",
+			}
+
+			expectedMangaMoreInfoBytes, err := json.Marshal(expectedMangaMoreInfo)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaMoreInfo should return a MangaMoreInfo given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaMoreInfoBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaMoreInfo, err := jikan.GetMangaMoreInfo(mangaID)
+
+				So(mangaMoreInfo, ShouldResemble, expectedMangaMoreInfo)
+				So(mangaMoreInfo.MoreInfo, ShouldEqual, expectedMangaMoreInfo.MoreInfo)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaMoreInfo should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaMoreInfo, err := jikan.GetMangaMoreInfo(mangaID)
+
+				So(mangaMoreInfo, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaMoreInfo should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaMoreInfo, err := jikan.GetMangaMoreInfo(0)
+
+				So(mangaMoreInfo, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaMoreInfo should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaMoreInfo, err := jikan.GetMangaMoreInfo(0)
+
+				So(mangaMoreInfo, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaRecommendations Method", func() {
+			expectedMangaRecommendations := MangaRecommendations{
+				Recommendations: []AnimeRecommendation{
+					AnimeRecommendation{
+						MalID:               1706,
+						URL:                 "https://myanimelist.net/manga/1706/20th_Century_Boys",
+						ImageURL:            "https://cdn.myanimelist.net/images/manga/5/260006.jpg",
+						RecommendationURL:   "https://myanimelist.net/recommendations/manga/1-1706",
+						Title:               "20th Century Boys",
+						RecommendationCount: 25,
+					},
+				},
+			}
+
+			expectedMangaRecommendationsBytes, err := json.Marshal(expectedMangaRecommendations)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaRecommendations should return a MangaRecommendations given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaRecommendationsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaRecommendations, err := jikan.GetMangaRecommendations(mangaID)
+
+				So(mangaRecommendations, ShouldResemble, expectedMangaRecommendations)
+				So(len(mangaRecommendations.Recommendations), ShouldEqual, 1)
+				So(mangaRecommendations.Recommendations[0].RecommendationCount, ShouldEqual, 25)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaRecommendations should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaRecommendations, err := jikan.GetMangaRecommendations(mangaID)
+
+				So(mangaRecommendations, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaRecommendations should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaRecommendations, err := jikan.GetMangaRecommendations(0)
+
+				So(mangaRecommendations, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaRecommendations should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaRecommendations, err := jikan.GetMangaRecommendations(0)
+
+				So(mangaRecommendations, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaReviews Method", func() {
+			expectedMangaReviews := MangaReviews{
+				Reviews: []MangaReview{
+					MangaReview{
+						MalID:        1,
+						URL:          "https://myanimelist.net/reviews.php?id=1",
+						HelpfulCount: 100,
+						Reviewer: MangaReviewer{
+							Username:     "Snow",
+							ChaptersRead: 162,
+							Scores: MangaReviewScore{
+								Overall: 10,
+								Art:     9,
+							},
+						},
+						Content: "This is a first review",
+					},
+				},
+			}
+
+			expectedMangaReviewsBytes, err := json.Marshal(expectedMangaReviews)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaReviews should return a MangaReviews given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaReviewsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaReviews, err := jikan.GetMangaReviews(mangaID, 0)
+
+				So(mangaReviews, ShouldResemble, expectedMangaReviews)
+				So(len(mangaReviews.Reviews), ShouldEqual, 1)
+				So(mangaReviews.Reviews[0].Reviewer.Scores.Art, ShouldEqual, 9)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaReviews should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaReviews, err := jikan.GetMangaReviews(mangaID, 0)
+
+				So(mangaReviews, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaReviews should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaReviews, err := jikan.GetMangaReviews(0, 0)
+
+				So(mangaReviews, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaReviews should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaReviews, err := jikan.GetMangaReviews(0, 0)
+
+				So(mangaReviews, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetMangaReviews should return MangaReviews given valid ID and page number 2", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaReviewsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/manga/1/reviews/2")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaReviews, err := jikan.GetMangaReviews(mangaID, 2)
+
+				So(mangaReviews, ShouldResemble, expectedMangaReviews)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("Testing GetMangaUserUpdates Method", func() {
+			expectedMangaUserUpdates := MangaUserUpdates{
+				Users: []MangaUserUpdate{
+					MangaUserUpdate{
+						Username:      "Snow",
+						URL:           "https://myanimelist.net/profile/Snow",
+						Score:         9,
+						Status:        "Reading",
+						VolumesRead:   3,
+						VolumesTotal:  18,
+						ChaptersRead:  25,
+						ChaptersTotal: 162,
+					},
+				},
+			}
+
+			expectedMangaUserUpdatesBytes, err := json.Marshal(expectedMangaUserUpdates)
+			So(err, ShouldBeNil)
+
+			Convey("GetMangaUserUpdates should return a MangaUserUpdates given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaUserUpdatesBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaUserUpdates, err := jikan.GetMangaUserUpdates(mangaID, 0)
+
+				So(mangaUserUpdates, ShouldResemble, expectedMangaUserUpdates)
+				So(len(mangaUserUpdates.Users), ShouldEqual, 1)
+				So(mangaUserUpdates.Users[0].ChaptersRead, ShouldEqual, 25)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMangaUserUpdates should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaUserUpdates, err := jikan.GetMangaUserUpdates(mangaID, 0)
+
+				So(mangaUserUpdates, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMangaUserUpdates should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaUserUpdates, err := jikan.GetMangaUserUpdates(0, 0)
+
+				So(mangaUserUpdates, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMangaUserUpdates should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaUserUpdates, err := jikan.GetMangaUserUpdates(0, 0)
+
+				So(mangaUserUpdates, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetMangaUserUpdates should return MangaUserUpdates given valid ID and page number 2", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaUserUpdatesBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/manga/1/userupdates/2")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaUserUpdates, err := jikan.GetMangaUserUpdates(mangaID, 2)
+
+				So(mangaUserUpdates, ShouldResemble, expectedMangaUserUpdates)
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package gojikan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ResponseMeta is a struct of Jikan's caching details included in every response
+type ResponseMeta struct {
+	RequestHash        string `json:"request_hash"`
+	RequestCached      bool   `json:"request_cached"`
+	RequestCacheExpiry int    `json:"request_cache_expiry"`
+}
+
+// MetaStatus is a struct of Jikan API's usage and uptime statistics
+// Jikan may return the numbers as JSON strings, so they are kept as json.Number
+type MetaStatus struct {
+	ResponseMeta
+
+	CachedRequests           json.Number `json:"cached_requests"`
+	RequestsToday            json.Number `json:"requests_today"`
+	RequestsThisWeek         json.Number `json:"requests_this_week"`
+	RequestsThisMonth        json.Number `json:"requests_this_month"`
+	ConnectedClients         json.Number `json:"connected_clients"`
+	TotalConnectionsReceived json.Number `json:"total_connections_received"`
+	TotalCommandsProcessed   json.Number `json:"total_commands_processed"`
+	UptimeInSeconds          json.Number `json:"uptime_in_seconds"`
+	UptimeInDays             json.Number `json:"uptime_in_days"`
+}
+
+// GetMetaStatus return Jikan API's status and can be used as a health check
+// It returns MyAnimeListError when Jikan is up but MyAnimeList is unreachable
+func (ths *jikanClient) GetMetaStatus() (metaStatus MetaStatus, err error) {
+	return ths.GetMetaStatusContext(context.Background())
+}
+
+func (ths *jikanClient) GetMetaStatusContext(ctx context.Context) (metaStatus MetaStatus, err error) {
+	url := fmt.Sprintf("%s/meta/status", ths.baseURL)
+
+	err = ths.get(ctx, url, &metaStatus)
+	return
+}
+
+// ===================================================================================================================================
+
+// MetaPeriod is a type of time period for Jikan API's request statistics
+type MetaPeriod string
+
+const (
+	// MetaPeriodToday is the request statistics of today
+	MetaPeriodToday MetaPeriod = "today"
+
+	// MetaPeriodWeekly is the request statistics of this week
+	MetaPeriodWeekly MetaPeriod = "weekly"
+
+	// MetaPeriodMonthly is the request statistics of this month
+	MetaPeriodMonthly MetaPeriod = "monthly"
+)
+
+// MetaRequests is a struct of request count per requested path in Jikan API
+type MetaRequests struct {
+	ResponseMeta
+
+	Requests map[string]int
+}
+
+// UnmarshalJSON separates the caching details from the request counts
+func (ths *MetaRequests) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var meta ResponseMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return err
+	}
+
+	requests := map[string]int{}
+	for key, value := range fields {
+		switch key {
+		case "request_hash", "request_cached", "request_cache_expiry":
+			continue
+		}
+
+		var count int
+		if err := json.Unmarshal(value, &count); err != nil {
+			return err
+		}
+
+		requests[key] = count
+	}
+
+	ths.ResponseMeta = meta
+	ths.Requests = requests
+	return nil
+}
+
+// MarshalJSON flattens the request counts next to the caching details like Jikan does
+func (ths MetaRequests) MarshalJSON() ([]byte, error) {
+	fields := map[string]interface{}{
+		"request_hash":         ths.RequestHash,
+		"request_cached":       ths.RequestCached,
+		"request_cache_expiry": ths.RequestCacheExpiry,
+	}
+
+	for key, count := range ths.Requests {
+		fields[key] = count
+	}
+
+	return json.Marshal(fields)
+}
+
+// GetMetaRequests return the most requested paths of the request type, e.g. anime, manga, search
+// in the period, 1000 paths per offset
+func (ths *jikanClient) GetMetaRequests(requestType string, period MetaPeriod, offset int) (metaRequests MetaRequests, err error) {
+	return ths.GetMetaRequestsContext(context.Background(), requestType, period, offset)
+}
+
+func (ths *jikanClient) GetMetaRequestsContext(ctx context.Context, requestType string, period MetaPeriod, offset int) (metaRequests MetaRequests, err error) {
+	url := fmt.Sprintf("%s/meta/requests/%s/%s/%d", ths.baseURL, requestType, period, offset)
+
+	err = ths.get(ctx, url, &metaRequests)
+	return
+}
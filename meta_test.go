@@ -0,0 +1,261 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMetaEndpoints(t *testing.T) {
+	Convey("Testing Meta Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		Convey("Testing GetMetaStatus Method", func() {
+			expectedMetaStatus := MetaStatus{
+				CachedRequests:           "1000",
+				RequestsToday:            "200",
+				RequestsThisWeek:         "1400",
+				RequestsThisMonth:        "6000",
+				ConnectedClients:         "12",
+				TotalConnectionsReceived: "300",
+				TotalCommandsProcessed:   "5000",
+				UptimeInSeconds:          "86400",
+				UptimeInDays:             "1",
+			}
+
+			expectedMetaStatusBytes, err := json.Marshal(expectedMetaStatus)
+			So(err, ShouldBeNil)
+
+			Convey("GetMetaStatus should return a MetaStatus given valid request", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMetaStatusBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/meta/status")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				metaStatus, err := jikan.GetMetaStatus()
+
+				So(metaStatus, ShouldResemble, expectedMetaStatus)
+				So(metaStatus.RequestsToday.String(), ShouldEqual, "200")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMetaStatus should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				metaStatus, err := jikan.GetMetaStatus()
+
+				So(metaStatus, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMetaStatus should return ResourceNotFoundError given unknown resource", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				metaStatus, err := jikan.GetMetaStatus()
+
+				So(metaStatus, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMetaStatus should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				metaStatus, err := jikan.GetMetaStatus()
+
+				So(metaStatus, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetMetaStatus should accept numbers encoded as JSON strings", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte(`{"cached_requests":"1000","requests_today":200}`)))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				metaStatus, err := jikan.GetMetaStatus()
+
+				cachedRequests, _ := metaStatus.CachedRequests.Int64()
+				requestsToday, _ := metaStatus.RequestsToday.Int64()
+
+				So(cachedRequests, ShouldEqual, 1000)
+				So(requestsToday, ShouldEqual, 200)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMetaStatus should return MyAnimeListError when MyAnimeList is down", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 503,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				metaStatus, err := jikan.GetMetaStatus()
+
+				So(metaStatus, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, MyAnimeListError)
+			})
+		})
+
+		Convey("Testing GetMetaRequests Method", func() {
+			expectedMetaRequests := MetaRequests{
+				ResponseMeta: ResponseMeta{
+					RequestHash:        "request:meta:1",
+					RequestCached:      true,
+					RequestCacheExpiry: 60,
+				},
+				Requests: map[string]int{
+					"/v3/anime/1":  100,
+					"/v3/anime/20": 50,
+				},
+			}
+
+			expectedMetaRequestsBytes, err := json.Marshal(expectedMetaRequests)
+			So(err, ShouldBeNil)
+
+			Convey("GetMetaRequests should return a MetaRequests given valid type and period", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMetaRequestsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/meta/requests/anime/today/0")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				metaRequests, err := jikan.GetMetaRequests("anime", MetaPeriodToday, 0)
+
+				So(metaRequests, ShouldResemble, expectedMetaRequests)
+				So(metaRequests.Requests["/v3/anime/1"], ShouldEqual, 100)
+				So(metaRequests.RequestCacheExpiry, ShouldEqual, 60)
+				So(len(metaRequests.Requests), ShouldEqual, 2)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetMetaRequests should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				metaRequests, err := jikan.GetMetaRequests("anime", MetaPeriodToday, 0)
+
+				So(metaRequests, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetMetaRequests should return ResourceNotFoundError given unknown type", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				metaRequests, err := jikan.GetMetaRequests("unknown", MetaPeriodToday, 0)
+
+				So(metaRequests, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetMetaRequests should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				metaRequests, err := jikan.GetMetaRequests("unknown", MetaPeriodToday, 0)
+
+				So(metaRequests, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestResponseMeta(t *testing.T) {
+	Convey("Testing ResponseMeta Decoding", t, func() {
+		body := []byte(`{"request_hash":"request:anime:1","request_cached":true,"request_cache_expiry":43200,"mal_id":1}`)
+
+		Convey("ResponseMeta should be decoded into every response struct", func() {
+			var anime Anime
+			So(json.Unmarshal(body, &anime), ShouldBeNil)
+
+			So(anime.RequestHash, ShouldEqual, "request:anime:1")
+			So(anime.RequestCached, ShouldBeTrue)
+			So(anime.RequestCacheExpiry, ShouldEqual, 43200)
+			So(anime.MalID, ShouldEqual, 1)
+
+			var animeVideos AnimeVideos
+			So(json.Unmarshal(body, &animeVideos), ShouldBeNil)
+
+			So(animeVideos.ResponseMeta, ShouldResemble, anime.ResponseMeta)
+		})
+
+		Convey("MetaRequests should return error given non numeric request count", func() {
+			var metaRequests MetaRequests
+
+			So(json.Unmarshal([]byte(`{"/v3/anime/1":"many"}`), &metaRequests), ShouldNotBeNil)
+			So(metaRequests, ShouldBeZeroValue)
+		})
+	})
+}
@@ -0,0 +1,98 @@
+package gojikan
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option is a function that configures the client created by NewJikanClient
+type Option func(*jikanClient)
+
+// WithBaseURL sets the base URL of Jikan API, e.g. to use a self-hosted Jikan instance
+func WithBaseURL(baseURL string) Option {
+	return func(jikan *jikanClient) {
+		if baseURL != "" {
+			jikan.baseURL = strings.TrimSuffix(baseURL, "/")
+		}
+	}
+}
+
+// WithV3BaseURL sets the base URL of Jikan API v3 used by NewJikanV4Client for the endpoints
+// that are not yet ported to v4
+func WithV3BaseURL(baseURL string) Option {
+	return func(jikan *jikanClient) {
+		if baseURL != "" {
+			jikan.v3BaseURL = strings.TrimSuffix(baseURL, "/")
+		}
+	}
+}
+
+// WithHTTPClient sets the client that sends the HTTP requests, e.g. a tuned *http.Client
+func WithHTTPClient(httpClient HTTPClient) Option {
+	return func(jikan *jikanClient) {
+		if httpClient != nil {
+			jikan.client = httpClient
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request
+func WithUserAgent(userAgent string) Option {
+	return func(jikan *jikanClient) {
+		jikan.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the maximum duration of every request
+// It applies to any HTTPClient because it is enforced through the request's context
+func WithTimeout(timeout time.Duration) Option {
+	return func(jikan *jikanClient) {
+		jikan.timeout = timeout
+	}
+}
+
+// WithDefaultHeaders sets headers sent with every request
+// Calling it more than once merges the headers, replacing the values of the same key
+func WithDefaultHeaders(headers http.Header) Option {
+	return func(jikan *jikanClient) {
+		if jikan.headers == nil {
+			jikan.headers = http.Header{}
+		}
+
+		for key, values := range headers {
+			jikan.headers[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+		}
+	}
+}
+
+// WithRateLimiter sets the rate limiter every request has to pass before being sent
+// Share the same RateLimiter between clients to share the quota
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(jikan *jikanClient) {
+		jikan.limiter = limiter
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried, requests are not retried by default
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(jikan *jikanClient) {
+		jikan.retry = &policy
+	}
+}
+
+// WithCache sets the cache of the response bodies, responses are cached for as long as
+// Jikan's request_cache_expiry or the Expires header allows
+func WithCache(cache Cache) Option {
+	return func(jikan *jikanClient) {
+		jikan.cache = cache
+	}
+}
+
+// WithRevalidationWindow sets how long an expired cached response with ETag or Last-Modified
+// is kept to be revalidated with If-None-Match or If-Modified-Since, it defaults to 24 hours
+func WithRevalidationWindow(window time.Duration) Option {
+	return func(jikan *jikanClient) {
+		jikan.revalidate = window
+	}
+}
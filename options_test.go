@@ -0,0 +1,147 @@
+package gojikan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClientOptions(t *testing.T) {
+	Convey("Testing Client Options", t, func() {
+		Convey("NewJikanClient without option should use the default base URL and http.Client", func() {
+			jikan := NewJikanClient().(*jikanClient)
+
+			So(jikan.baseURL, ShouldEqual, "https://api.jikan.moe/v3")
+			So(jikan.client, ShouldResemble, &http.Client{})
+			So(jikan.userAgent, ShouldBeEmpty)
+			So(jikan.timeout, ShouldEqual, 0)
+			So(jikan.headers, ShouldBeNil)
+		})
+
+		Convey("WithBaseURL should replace the base URL without trailing slash", func() {
+			jikan := NewJikanClient(WithBaseURL("http://localhost:8080/v3/")).(*jikanClient)
+
+			So(jikan.baseURL, ShouldEqual, "http://localhost:8080/v3")
+
+			jikan = NewJikanClient(WithBaseURL("")).(*jikanClient)
+
+			So(jikan.baseURL, ShouldEqual, "https://api.jikan.moe/v3")
+		})
+
+		Convey("WithHTTPClient should replace the HTTP client unless it is nil", func() {
+			mockClient := &MockClient{}
+
+			So(NewJikanClient(WithHTTPClient(mockClient)).(*jikanClient).client, ShouldEqual, mockClient)
+			So(NewJikanClient(WithHTTPClient(nil)).(*jikanClient).client, ShouldResemble, &http.Client{})
+		})
+
+		Convey("Requests should be sent to the base URL with the user agent and default headers", func() {
+			jikan := NewJikanClient(
+				WithBaseURL("http://localhost:8080/v3"),
+				WithUserAgent("gojikan-test/1.0"),
+				WithDefaultHeaders(http.Header{"x-api-key": []string{"secret"}}),
+				WithDefaultHeaders(http.Header{"Accept": []string{"application/json"}}),
+				WithHTTPClient(&MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.String(), ShouldEqual, "http://localhost:8080/v3/anime/1")
+						So(req.Header.Get("User-Agent"), ShouldEqual, "gojikan-test/1.0")
+						So(req.Header.Get("X-Api-Key"), ShouldEqual, "secret")
+						So(req.Header.Get("Accept"), ShouldEqual, "application/json")
+
+						return &http.Response{StatusCode: 404}, nil
+					},
+				}),
+			)
+
+			_, err := jikan.GetAnime(1)
+
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+		})
+
+		Convey("WithUserAgent should take precedence over User-Agent in default headers", func() {
+			jikan := NewJikanClient(
+				WithDefaultHeaders(http.Header{"User-Agent": []string{"from-headers"}}),
+				WithUserAgent("from-option"),
+				WithHTTPClient(&MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.Header.Get("User-Agent"), ShouldEqual, "from-option")
+
+						return &http.Response{StatusCode: 404}, nil
+					},
+				}),
+			)
+
+			_, err := jikan.GetAnime(1)
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("WithDefaultHeaders should not share the given header map", func() {
+			headers := http.Header{"X-Api-Key": []string{"secret"}}
+			jikan := NewJikanClient(WithDefaultHeaders(headers)).(*jikanClient)
+			headers.Set("X-Api-Key", "changed")
+
+			So(jikan.headers.Get("X-Api-Key"), ShouldEqual, "secret")
+		})
+
+		Convey("WithTimeout should cancel requests that take too long", func() {
+			jikan := NewJikanClient(
+				WithTimeout(10*time.Millisecond),
+				WithHTTPClient(&MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						_, ok := req.Context().Deadline()
+						So(ok, ShouldBeTrue)
+
+						<-req.Context().Done()
+						return nil, req.Context().Err()
+					},
+				}),
+			)
+
+			anime, err := jikan.GetAnime(1)
+
+			So(anime, ShouldBeZeroValue)
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+		})
+
+		Convey("NewJikanV4Client should apply the options and WithBaseURL to the v4 base URL", func() {
+			mockClient := &MockClient{}
+			jikan := NewJikanV4Client(WithBaseURL("http://localhost:8080/v4"), WithHTTPClient(mockClient)).(*jikanV4Client)
+
+			So(jikan.v4BaseURL, ShouldEqual, "http://localhost:8080/v4")
+			So(jikan.baseURL, ShouldEqual, "http://localhost:8080/v3")
+			So(jikan.client, ShouldEqual, mockClient)
+
+			jikan = NewJikanV4Client().(*jikanV4Client)
+
+			So(jikan.v4BaseURL, ShouldEqual, "https://api.jikan.moe/v4")
+			So(jikan.baseURL, ShouldEqual, "https://api.jikan.moe/v3")
+		})
+
+		Convey("NewJikanV4Client should keep the endpoints not ported to v4 on the configured host", func() {
+			var urls []string
+			mockClient := &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					urls = append(urls, req.URL.String())
+					return nil, errors.New("API call failed")
+				},
+			}
+
+			NewJikanV4Client(WithBaseURL("https://mirror/api"), WithHTTPClient(mockClient)).GetManga(1)
+			NewJikanV4Client(
+				WithBaseURL("https://mirror/v4"),
+				WithV3BaseURL("https://legacy-mirror/v3/"),
+				WithHTTPClient(mockClient),
+			).GetManga(1)
+
+			So(urls, ShouldResemble, []string{
+				"https://mirror/api/manga/1",
+				"https://legacy-mirror/v3/manga/1",
+			})
+		})
+	})
+}
@@ -0,0 +1,85 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PersonResource is a struct of anime, manga or character related to the person
+type PersonResource struct {
+	MalID    int    `json:"mal_id"`
+	URL      string `json:"url"`
+	ImageURL string `json:"image_url"`
+	Name     string `json:"name"`
+}
+
+// PersonVoiceActingRole is a struct of character voiced by the person in an anime
+type PersonVoiceActingRole struct {
+	Role      string         `json:"role"`
+	Anime     PersonResource `json:"anime"`
+	Character PersonResource `json:"character"`
+}
+
+// PersonAnimeStaffPosition is a struct of the person's staff position in an anime
+type PersonAnimeStaffPosition struct {
+	Position string         `json:"position"`
+	Anime    PersonResource `json:"anime"`
+}
+
+// PersonPublishedManga is a struct of manga published by the person
+type PersonPublishedManga struct {
+	Position string         `json:"position"`
+	Manga    PersonResource `json:"manga"`
+}
+
+// Person is a struct of person details from MyAnimeList
+type Person struct {
+	ResponseMeta
+
+	MalID               int                        `json:"mal_id"`
+	URL                 string                     `json:"url"`
+	ImageURL            string                     `json:"image_url"`
+	WebsiteURL          string                     `json:"website_url"`
+	Name                string                     `json:"name"`
+	GivenName           string                     `json:"given_name"`
+	FamilyName          string                     `json:"family_name"`
+	AlternateNames      []string                   `json:"alternate_names"`
+	Birthday            time.Time                  `json:"birthday"`
+	MemberFavorites     int                        `json:"member_favorites"`
+	About               string                     `json:"about"`
+	VoiceActingRoles    []PersonVoiceActingRole    `json:"voice_acting_roles"`
+	AnimeStaffPositions []PersonAnimeStaffPosition `json:"anime_staff_positions"`
+	PublishedManga      []PersonPublishedManga     `json:"published_manga"`
+}
+
+func (ths *jikanClient) GetPerson(id int) (person Person, err error) {
+	return ths.GetPersonContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetPersonContext(ctx context.Context, id int) (person Person, err error) {
+	url := fmt.Sprintf("%s/person/%d", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &person)
+	return
+}
+
+// ===================================================================================================================================
+
+// PersonPictures is a struct of related pictures of the person
+type PersonPictures struct {
+	ResponseMeta
+
+	Pictures []AnimePicture `json:"pictures"`
+}
+
+func (ths *jikanClient) GetPersonPictures(id int) (personPictures PersonPictures, err error) {
+	return ths.GetPersonPicturesContext(context.Background(), id)
+}
+
+func (ths *jikanClient) GetPersonPicturesContext(ctx context.Context, id int) (personPictures PersonPictures, err error) {
+	url := fmt.Sprintf("%s/person/%d/pictures", ths.baseURL, id)
+
+	err = ths.get(ctx, url, &personPictures)
+	return
+}
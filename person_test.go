@@ -0,0 +1,223 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPersonEndpoints(t *testing.T) {
+	Convey("Testing Person Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+		personID := 11
+
+		Convey("Testing GetPerson Method", func() {
+			expectedPerson := Person{
+				MalID:           personID,
+				URL:             "https://myanimelist.net/people/11/Kouichi_Yamadera",
+				ImageURL:        "https://cdn.myanimelist.net/images/voiceactors/1/54600.jpg",
+				Name:            "Kouichi Yamadera",
+				GivenName:       "耕一",
+				FamilyName:      "山寺",
+				AlternateNames:  []string{"Kōichi Yamadera"},
+				Birthday:        time.Date(1961, time.June, 17, 0, 0, 0, 0, time.UTC),
+				MemberFavorites: 20000,
+				VoiceActingRoles: []PersonVoiceActingRole{
+					PersonVoiceActingRole{
+						Role: "Main",
+						Anime: PersonResource{
+							MalID: 1,
+							URL:   "https://myanimelist.net/anime/1/Cowboy_Bebop",
+							Name:  "Cowboy Bebop",
+						},
+						Character: PersonResource{
+							MalID: 1,
+							URL:   "https://myanimelist.net/character/1/Spike_Spiegel",
+							Name:  "Spiegel, Spike",
+						},
+					},
+				},
+				AnimeStaffPositions: []PersonAnimeStaffPosition{
+					PersonAnimeStaffPosition{
+						Position: "Theme Song Performance",
+						Anime: PersonResource{
+							MalID: 1,
+							Name:  "Cowboy Bebop",
+						},
+					},
+				},
+				PublishedManga: []PersonPublishedManga{
+					PersonPublishedManga{
+						Position: "Story",
+						Manga: PersonResource{
+							MalID: 2,
+							Name:  "Some Manga",
+						},
+					},
+				},
+			}
+
+			expectedPersonBytes, err := json.Marshal(expectedPerson)
+			So(err, ShouldBeNil)
+
+			Convey("GetPerson should return a Person given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedPersonBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				person, err := jikan.GetPerson(personID)
+
+				So(person, ShouldResemble, expectedPerson)
+				So(person.MalID, ShouldEqual, personID)
+				So(person.VoiceActingRoles[0].Character.Name, ShouldEqual, "Spiegel, Spike")
+				So(person.AnimeStaffPositions[0].Position, ShouldEqual, "Theme Song Performance")
+				So(person.PublishedManga[0].Manga.MalID, ShouldEqual, 2)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetPerson should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				person, err := jikan.GetPerson(personID)
+
+				So(person, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetPerson should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				person, err := jikan.GetPerson(0)
+
+				So(person, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetPerson should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				person, err := jikan.GetPerson(0)
+
+				So(person, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetPersonPictures Method", func() {
+			expectedPersonPictures := PersonPictures{
+				Pictures: []AnimePicture{
+					AnimePicture{
+						Large: "https://cdn.myanimelist.net/images/voiceactors/1/54600l.jpg",
+						Small: "https://cdn.myanimelist.net/images/voiceactors/1/54600.jpg",
+					},
+				},
+			}
+
+			expectedPersonPicturesBytes, err := json.Marshal(expectedPersonPictures)
+			So(err, ShouldBeNil)
+
+			Convey("GetPersonPictures should return a PersonPictures given valid ID", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedPersonPicturesBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				personPictures, err := jikan.GetPersonPictures(personID)
+
+				So(personPictures, ShouldResemble, expectedPersonPictures)
+				So(len(personPictures.Pictures), ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetPersonPictures should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				personPictures, err := jikan.GetPersonPictures(personID)
+
+				So(personPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetPersonPictures should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				personPictures, err := jikan.GetPersonPictures(0)
+
+				So(personPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetPersonPictures should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				personPictures, err := jikan.GetPersonPictures(0)
+
+				So(personPictures, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,178 @@
+package gojikan
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ClientRateLimitedError is an error message for request rejected by the client's rate limiter
+const ClientRateLimitedError = "Too many request sent. Rate limited by the client"
+
+// ErrClientRateLimited is returned when the rate limiter with RateLimitFailFast has no quota left
+var ErrClientRateLimited = errors.New(ClientRateLimitedError)
+
+// RateLimitPolicy is a type of what the rate limiter does when there is no quota left
+type RateLimitPolicy int
+
+const (
+	// RateLimitWait blocks the request until there is quota or the context is done
+	RateLimitWait RateLimitPolicy = iota
+
+	// RateLimitFailFast returns ClientRateLimitedError without sending the request
+	RateLimitFailFast
+)
+
+// RateLimit is a struct of a quota of requests per period
+// Burst is the number of requests that can be sent at once, it defaults to Requests
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// DefaultRateLimits are Jikan's quotas of 3 requests per second and 60 requests per minute
+var DefaultRateLimits = []RateLimit{
+	RateLimit{Requests: 3, Per: time.Second},
+	RateLimit{Requests: 60, Per: time.Minute},
+}
+
+type tokenBucket struct {
+	capacity float64
+	tokens   float64
+	interval time.Duration
+	last     time.Time
+}
+
+func (ths *tokenBucket) advance(now time.Time) {
+	if ths.last.IsZero() {
+		ths.last = now
+	} else if now.After(ths.last) {
+		ths.tokens += float64(now.Sub(ths.last)) / float64(ths.interval)
+		if ths.tokens > ths.capacity {
+			ths.tokens = ths.capacity
+		}
+
+		ths.last = now
+	}
+}
+
+func (ths *tokenBucket) waitTime() time.Duration {
+	if ths.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - ths.tokens) * float64(ths.interval))
+}
+
+// RateLimiter is a token bucket rate limiter that is safe to share across goroutines and clients
+// Every request has to get a token from all of its limits
+type RateLimiter struct {
+	mu      sync.Mutex
+	policy  RateLimitPolicy
+	buckets []*tokenBucket
+	now     func() time.Time
+}
+
+// NewRateLimiter return a RateLimiter with the given policy and limits
+// Put no limits to use DefaultRateLimits
+func NewRateLimiter(policy RateLimitPolicy, limits ...RateLimit) *RateLimiter {
+	if len(limits) == 0 {
+		limits = DefaultRateLimits
+	}
+
+	limiter := &RateLimiter{
+		policy: policy,
+		now:    time.Now,
+	}
+
+	for _, limit := range limits {
+		if limit.Requests <= 0 || limit.Per <= 0 {
+			continue
+		}
+
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = limit.Requests
+		}
+
+		limiter.buckets = append(limiter.buckets, &tokenBucket{
+			capacity: float64(burst),
+			tokens:   float64(burst),
+			interval: limit.Per / time.Duration(limit.Requests),
+		})
+	}
+
+	return limiter
+}
+
+// WaitTime return how long the next request has to wait for its quota
+func (ths *RateLimiter) WaitTime() time.Duration {
+	ths.mu.Lock()
+	defer ths.mu.Unlock()
+
+	return ths.waitTime(ths.now())
+}
+
+func (ths *RateLimiter) waitTime(now time.Time) (wait time.Duration) {
+	for _, bucket := range ths.buckets {
+		bucket.advance(now)
+		if bucketWait := bucket.waitTime(); bucketWait > wait {
+			wait = bucketWait
+		}
+	}
+
+	return
+}
+
+// Wait takes a token for a request, blocking according to the policy until the token is available
+// The token is given back when the context is done before the wait is over
+func (ths *RateLimiter) Wait(ctx context.Context) error {
+	ths.mu.Lock()
+	now := ths.now()
+	wait := ths.waitTime(now)
+
+	if wait > 0 && ths.policy == RateLimitFailFast {
+		ths.mu.Unlock()
+		return ErrClientRateLimited
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(wait)) {
+		ths.mu.Unlock()
+		return context.DeadlineExceeded
+	}
+
+	for _, bucket := range ths.buckets {
+		bucket.tokens--
+	}
+	ths.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		ths.mu.Lock()
+		ths.refund(ths.now())
+		ths.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// refund gives back a reserved token without going over the capacity,
+// as the other buckets may have refilled while the request was waiting
+func (ths *RateLimiter) refund(now time.Time) {
+	for _, bucket := range ths.buckets {
+		bucket.advance(now)
+		bucket.tokens = math.Min(bucket.tokens+1, bucket.capacity)
+	}
+}
@@ -0,0 +1,187 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRateLimiter(t *testing.T) {
+	Convey("Testing RateLimiter", t, func() {
+		now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		clock := func() time.Time { return now }
+
+		Convey("NewRateLimiter without limits should use DefaultRateLimits", func() {
+			limiter := NewRateLimiter(RateLimitWait)
+
+			So(limiter.buckets, ShouldHaveLength, 2)
+			So(limiter.buckets[0].capacity, ShouldEqual, 3)
+			So(limiter.buckets[1].capacity, ShouldEqual, 60)
+			So(limiter.WaitTime(), ShouldEqual, 0)
+		})
+
+		Convey("Burst should allow that many requests at once and refill over time", func() {
+			limiter := NewRateLimiter(RateLimitFailFast, RateLimit{Requests: 1, Per: time.Second, Burst: 2})
+			limiter.now = clock
+
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+			So(limiter.WaitTime(), ShouldEqual, time.Second)
+
+			now = now.Add(400 * time.Millisecond)
+			So(limiter.WaitTime(), ShouldEqual, 600*time.Millisecond)
+
+			now = now.Add(10 * time.Second)
+			So(limiter.WaitTime(), ShouldEqual, 0)
+			So(limiter.buckets[0].tokens, ShouldEqual, 2)
+		})
+
+		Convey("The longest wait of all limits should be used", func() {
+			limiter := NewRateLimiter(RateLimitFailFast,
+				RateLimit{Requests: 10, Per: time.Second},
+				RateLimit{Requests: 1, Per: time.Minute},
+			)
+			limiter.now = clock
+
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+			So(limiter.WaitTime(), ShouldEqual, time.Minute)
+		})
+
+		Convey("RateLimitFailFast should return ClientRateLimitedError without taking a token", func() {
+			limiter := NewRateLimiter(RateLimitFailFast, RateLimit{Requests: 1, Per: time.Second})
+			limiter.now = clock
+
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+
+			err := limiter.Wait(context.Background())
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, ClientRateLimitedError)
+			So(limiter.WaitTime(), ShouldEqual, time.Second)
+		})
+
+		Convey("RateLimitWait should block until the token is available", func() {
+			limiter := NewRateLimiter(RateLimitWait, RateLimit{Requests: 1, Per: 50 * time.Millisecond})
+
+			start := time.Now()
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+			So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 40*time.Millisecond)
+		})
+
+		Convey("RateLimitWait should return early when the deadline is before the token is available", func() {
+			limiter := NewRateLimiter(RateLimitWait, RateLimit{Requests: 1, Per: time.Hour})
+			limiter.now = clock
+
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			err := limiter.Wait(ctx)
+
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			So(limiter.WaitTime(), ShouldEqual, time.Hour)
+		})
+
+		Convey("RateLimitWait should give the token back when the context is cancelled", func() {
+			limiter := NewRateLimiter(RateLimitWait, RateLimit{Requests: 1, Per: time.Hour})
+
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				cancel()
+			}()
+
+			err := limiter.Wait(ctx)
+
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			So(limiter.WaitTime(), ShouldBeLessThanOrEqualTo, time.Hour)
+			So(limiter.WaitTime(), ShouldBeGreaterThan, 59*time.Minute)
+		})
+
+		Convey("RateLimitWait should not give back more tokens than the capacity of the refilled limits", func() {
+			var mu sync.Mutex
+			limiter := NewRateLimiter(RateLimitWait,
+				RateLimit{Requests: 3, Per: time.Second},
+				RateLimit{Requests: 1, Per: time.Minute},
+			)
+			limiter.now = func() time.Time {
+				mu.Lock()
+				defer mu.Unlock()
+				return now
+			}
+
+			So(limiter.Wait(context.Background()), ShouldBeNil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				mu.Lock()
+				now = now.Add(2 * time.Second)
+				mu.Unlock()
+				limiter.WaitTime()
+				cancel()
+			}()
+
+			err := limiter.Wait(ctx)
+
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			So(limiter.buckets[0].tokens, ShouldEqual, 3)
+			So(limiter.buckets[1].tokens, ShouldBeLessThanOrEqualTo, 1)
+		})
+
+		Convey("RateLimiter should be safe to share across goroutines", func() {
+			limiter := NewRateLimiter(RateLimitFailFast, RateLimit{Requests: 5, Per: time.Hour})
+
+			var wg sync.WaitGroup
+			var allowed int32
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if limiter.Wait(context.Background()) == nil {
+						atomic.AddInt32(&allowed, 1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			So(allowed, ShouldEqual, 5)
+		})
+
+		Convey("Client with rate limiter should not send the request when the limiter fails", func() {
+			var sent int32
+			jikan := NewJikanClient(
+				WithRateLimiter(NewRateLimiter(RateLimitFailFast, RateLimit{Requests: 1, Per: time.Hour})),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						atomic.AddInt32(&sent, 1)
+						return &http.Response{
+							StatusCode: 200,
+							Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+						}, nil
+					},
+				}),
+			)
+
+			_, err := jikan.GetAnime(1)
+			So(err, ShouldBeNil)
+
+			_, err = jikan.GetAnime(1)
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, ClientRateLimitedError)
+			So(sent, ShouldEqual, 1)
+		})
+	})
+}
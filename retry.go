@@ -0,0 +1,146 @@
+package gojikan
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy is a struct of how failed requests are retried
+// MaxAttempts includes the first attempt, so 1 means no retry
+// Jitter is the fraction (0 to 1) of the backoff that is randomly taken off
+type RetryPolicy struct {
+	MaxAttempts          int
+	BaseBackoff          time.Duration
+	MaxBackoff           time.Duration
+	Jitter               float64
+	RetryableStatusCodes []int
+	RetryNetworkErrors   bool
+	IsRetryableError     func(err error) bool
+	OnRetry              func(attempt RetryAttempt)
+}
+
+// RetryAttempt is a struct of a failed attempt that is going to be retried
+// Response is nil when the attempt failed with Err, and its body is already closed
+type RetryAttempt struct {
+	Attempt  int
+	URL      string
+	Response *http.Response
+	Err      error
+	Wait     time.Duration
+}
+
+// DefaultRetryPolicy return a RetryPolicy that retries rate limited, Jikan and MyAnimeList errors
+// and network errors up to 3 attempts
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          3,
+		BaseBackoff:          500 * time.Millisecond,
+		MaxBackoff:           10 * time.Second,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable},
+		RetryNetworkErrors:   true,
+	}
+}
+
+// backoff return how long to wait before the next attempt and whether it should be retried
+func (ths *RetryPolicy) backoff(ctx context.Context, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if ths == nil || attempt >= ths.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	if err != nil {
+		if !ths.retryableError(err) {
+			return 0, false
+		}
+	} else if !ths.retryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	wait, ok := retryAfter(resp, time.Now())
+	if !ok {
+		wait = ths.exponential(attempt)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		return 0, false
+	}
+
+	return wait, true
+}
+
+func (ths *RetryPolicy) retryableError(err error) bool {
+	if ths.IsRetryableError != nil {
+		return ths.IsRetryableError(err)
+	}
+
+	return ths.RetryNetworkErrors
+}
+
+func (ths *RetryPolicy) retryableStatus(status int) bool {
+	for _, code := range ths.RetryableStatusCodes {
+		if code == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ths *RetryPolicy) exponential(attempt int) time.Duration {
+	wait := ths.BaseBackoff
+	for i := 1; i < attempt && (ths.MaxBackoff <= 0 || wait < ths.MaxBackoff); i++ {
+		wait *= 2
+	}
+
+	if ths.MaxBackoff > 0 && wait > ths.MaxBackoff {
+		wait = ths.MaxBackoff
+	}
+
+	if ths.Jitter > 0 {
+		wait -= time.Duration(float64(wait) * ths.Jitter * rand.Float64())
+	}
+
+	return wait
+}
+
+func (ths *RetryPolicy) notify(attempt RetryAttempt) {
+	if ths.OnRetry != nil {
+		ths.OnRetry(attempt)
+	}
+}
+
+// retryAfter parses the Retry-After header of the response,
+// either in seconds or in HTTP date
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+
+	return 0, true
+}
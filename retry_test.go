@@ -0,0 +1,200 @@
+package gojikan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	Convey("Testing RetryPolicy", t, func() {
+		policy := RetryPolicy{
+			MaxAttempts:          3,
+			BaseBackoff:          time.Millisecond,
+			MaxBackoff:           3 * time.Millisecond,
+			RetryableStatusCodes: []int{429, 503},
+		}
+
+		newResponse := func(status int, header http.Header) *http.Response {
+			return &http.Response{
+				StatusCode: status,
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"mal_id":1}`))),
+			}
+		}
+
+		Convey("Exponential backoff should double up to the max backoff", func() {
+			So(policy.exponential(1), ShouldEqual, time.Millisecond)
+			So(policy.exponential(2), ShouldEqual, 2*time.Millisecond)
+			So(policy.exponential(3), ShouldEqual, 3*time.Millisecond)
+			So(policy.exponential(10), ShouldEqual, 3*time.Millisecond)
+
+			policy.Jitter = 0.5
+			for i := 0; i < 10; i++ {
+				So(policy.exponential(2), ShouldBeBetweenOrEqual, time.Millisecond, 2*time.Millisecond)
+			}
+		})
+
+		Convey("Retry-After should be parsed from seconds and HTTP date", func() {
+			now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			wait, ok := retryAfter(newResponse(429, http.Header{"Retry-After": []string{"7"}}), now)
+			So(ok, ShouldBeTrue)
+			So(wait, ShouldEqual, 7*time.Second)
+
+			wait, ok = retryAfter(newResponse(429, http.Header{"Retry-After": []string{"Wed, 01 Jan 2020 00:00:30 GMT"}}), now)
+			So(ok, ShouldBeTrue)
+			So(wait, ShouldEqual, 30*time.Second)
+
+			_, ok = retryAfter(newResponse(429, http.Header{"Retry-After": []string{"soon"}}), now)
+			So(ok, ShouldBeFalse)
+
+			_, ok = retryAfter(nil, now)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Client should retry retryable status until it succeeds", func() {
+			var attempts []RetryAttempt
+			policy.OnRetry = func(attempt RetryAttempt) {
+				attempts = append(attempts, attempt)
+			}
+
+			calls := 0
+			jikan := NewJikanClient(
+				WithRetryPolicy(policy),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						calls++
+						if calls == 1 {
+							return newResponse(503, nil), nil
+						}
+						if calls == 2 {
+							return newResponse(429, http.Header{"Retry-After": []string{"0"}}), nil
+						}
+						return newResponse(200, nil), nil
+					},
+				}),
+			)
+
+			anime, err := jikan.GetAnime(1)
+
+			So(err, ShouldBeNil)
+			So(anime.MalID, ShouldEqual, 1)
+			So(calls, ShouldEqual, 3)
+			So(attempts, ShouldHaveLength, 2)
+			So(attempts[0].Attempt, ShouldEqual, 1)
+			So(attempts[0].URL, ShouldEqual, "https://api.jikan.moe/v3/anime/1")
+			So(attempts[0].Response.StatusCode, ShouldEqual, 503)
+			So(attempts[0].Wait, ShouldEqual, time.Millisecond)
+			So(attempts[1].Response.StatusCode, ShouldEqual, 429)
+			So(attempts[1].Wait, ShouldEqual, 0)
+		})
+
+		Convey("Client should return the last status error when attempts run out", func() {
+			calls := 0
+			jikan := NewJikanClient(
+				WithRetryPolicy(policy),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						calls++
+						return newResponse(503, nil), nil
+					},
+				}),
+			)
+
+			_, err := jikan.GetAnime(1)
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, MyAnimeListError)
+			So(calls, ShouldEqual, 3)
+		})
+
+		Convey("Client should not retry status that is not retryable", func() {
+			calls := 0
+			jikan := NewJikanClient(
+				WithRetryPolicy(policy),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						calls++
+						return newResponse(404, nil), nil
+					},
+				}),
+			)
+
+			_, err := jikan.GetAnime(1)
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("Client should retry network errors only when enabled", func() {
+			calls := 0
+			mockClient := &MockClient{
+				MockDo: func(*http.Request) (*http.Response, error) {
+					calls++
+					return nil, errors.New("API call failed")
+				},
+			}
+
+			_, err := NewJikanClient(WithRetryPolicy(policy), WithHTTPClient(mockClient)).GetAnime(1)
+
+			So(err, ShouldBeError)
+			So(calls, ShouldEqual, 1)
+
+			calls = 0
+			policy.RetryNetworkErrors = true
+			_, err = NewJikanClient(WithRetryPolicy(policy), WithHTTPClient(mockClient)).GetAnime(1)
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, "API call failed")
+			So(calls, ShouldEqual, 3)
+
+			calls = 0
+			policy.IsRetryableError = func(err error) bool { return false }
+			_, err = NewJikanClient(WithRetryPolicy(policy), WithHTTPClient(mockClient)).GetAnime(1)
+
+			So(err, ShouldBeError)
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("Client should not wait for Retry-After beyond the context deadline", func() {
+			calls := 0
+			jikan := NewJikanClient(
+				WithRetryPolicy(policy),
+				WithHTTPClient(&MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						calls++
+						return newResponse(429, http.Header{"Retry-After": []string{"3600"}}), nil
+					},
+				}),
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			_, err := jikan.GetAnimeContext(ctx, 1)
+
+			So(err, ShouldBeError)
+			So(err.Error(), ShouldEqual, RateLimitedError)
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("DefaultRetryPolicy should retry rate limited and upstream errors", func() {
+			policy := DefaultRetryPolicy()
+
+			So(policy.MaxAttempts, ShouldEqual, 3)
+			So(policy.RetryNetworkErrors, ShouldBeTrue)
+			So(policy.retryableStatus(429), ShouldBeTrue)
+			So(policy.retryableStatus(500), ShouldBeTrue)
+			So(policy.retryableStatus(503), ShouldBeTrue)
+			So(policy.retryableStatus(404), ShouldBeFalse)
+		})
+	})
+}
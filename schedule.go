@@ -0,0 +1,183 @@
+package gojikan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Weekday is a type of day in the weekly broadcast schedule
+type Weekday string
+
+const (
+	// WeekdayMonday is the schedule of anime airing on Mondays
+	WeekdayMonday Weekday = "monday"
+
+	// WeekdayTuesday is the schedule of anime airing on Tuesdays
+	WeekdayTuesday Weekday = "tuesday"
+
+	// WeekdayWednesday is the schedule of anime airing on Wednesdays
+	WeekdayWednesday Weekday = "wednesday"
+
+	// WeekdayThursday is the schedule of anime airing on Thursdays
+	WeekdayThursday Weekday = "thursday"
+
+	// WeekdayFriday is the schedule of anime airing on Fridays
+	WeekdayFriday Weekday = "friday"
+
+	// WeekdaySaturday is the schedule of anime airing on Saturdays
+	WeekdaySaturday Weekday = "saturday"
+
+	// WeekdaySunday is the schedule of anime airing on Sundays
+	WeekdaySunday Weekday = "sunday"
+
+	// WeekdayOther is the schedule of anime airing irregularly
+	WeekdayOther Weekday = "other"
+
+	// WeekdayUnknown is the schedule of anime with unknown airing day
+	WeekdayUnknown Weekday = "unknown"
+)
+
+// Schedule is a struct of airing anime grouped by its broadcast day
+type Schedule struct {
+	ResponseMeta
+
+	Monday    []SeasonAnime `json:"monday"`
+	Tuesday   []SeasonAnime `json:"tuesday"`
+	Wednesday []SeasonAnime `json:"wednesday"`
+	Thursday  []SeasonAnime `json:"thursday"`
+	Friday    []SeasonAnime `json:"friday"`
+	Saturday  []SeasonAnime `json:"saturday"`
+	Sunday    []SeasonAnime `json:"sunday"`
+	Other     []SeasonAnime `json:"other"`
+	Unknown   []SeasonAnime `json:"unknown"`
+}
+
+// Day return airing anime of the given day in the schedule
+func (ths Schedule) Day(day Weekday) []SeasonAnime {
+	switch day {
+	case WeekdayMonday:
+		return ths.Monday
+	case WeekdayTuesday:
+		return ths.Tuesday
+	case WeekdayWednesday:
+		return ths.Wednesday
+	case WeekdayThursday:
+		return ths.Thursday
+	case WeekdayFriday:
+		return ths.Friday
+	case WeekdaySaturday:
+		return ths.Saturday
+	case WeekdaySunday:
+		return ths.Sunday
+	case WeekdayOther:
+		return ths.Other
+	case WeekdayUnknown:
+		return ths.Unknown
+	}
+
+	return nil
+}
+
+// GetSchedule return airing anime of the given day
+// Put empty string in day parameter to get the schedule of the whole week
+func (ths *jikanClient) GetSchedule(day Weekday) (schedule Schedule, err error) {
+	return ths.GetScheduleContext(context.Background(), day)
+}
+
+func (ths *jikanClient) GetScheduleContext(ctx context.Context, day Weekday) (schedule Schedule, err error) {
+	url := fmt.Sprintf("%s/schedule", ths.baseURL)
+	if day != "" {
+		url = fmt.Sprintf("%s/%s", url, day)
+	}
+
+	err = ths.get(ctx, url, &schedule)
+	return
+}
+
+// ===================================================================================================================================
+
+// InvalidBroadcastError is an error message for broadcast string without weekly airing time
+const InvalidBroadcastError = "Broadcast is not a weekly airing time"
+
+var (
+	broadcastPattern = regexp.MustCompile(`^(Mon|Tues|Wednes|Thurs|Fri|Satur|Sun)days at (\d{2}):(\d{2}) \((\w+)\)$`)
+
+	broadcastWeekdays = map[string]time.Weekday{
+		"Mon":    time.Monday,
+		"Tues":   time.Tuesday,
+		"Wednes": time.Wednesday,
+		"Thurs":  time.Thursday,
+		"Fri":    time.Friday,
+		"Satur":  time.Saturday,
+		"Sun":    time.Sunday,
+	}
+
+	// JST has no daylight saving time, so a fixed zone avoids depending on tzdata
+	broadcastLocations = map[string]*time.Location{
+		"JST": time.FixedZone("JST", 9*60*60),
+		"UTC": time.UTC,
+	}
+)
+
+// BroadcastTime is a struct of weekly airing time of an anime
+type BroadcastTime struct {
+	Weekday   time.Weekday
+	TimeOfDay time.Duration
+	Location  *time.Location
+}
+
+// ParseBroadcast parses Jikan's broadcast string like "Saturdays at 01:00 (JST)"
+// It returns InvalidBroadcastError for "Unknown" or any irregular broadcast
+func ParseBroadcast(broadcast string) (broadcastTime BroadcastTime, err error) {
+	matches := broadcastPattern.FindStringSubmatch(broadcast)
+	if matches == nil {
+		err = errors.New(InvalidBroadcastError)
+		return
+	}
+
+	hour, _ := strconv.Atoi(matches[2])
+	minute, _ := strconv.Atoi(matches[3])
+	location, ok := broadcastLocations[matches[4]]
+	if hour > 23 || minute > 59 || !ok {
+		err = errors.New(InvalidBroadcastError)
+		return
+	}
+
+	broadcastTime = BroadcastTime{
+		Weekday:   broadcastWeekdays[matches[1]],
+		TimeOfDay: time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute,
+		Location:  location,
+	}
+
+	return
+}
+
+// Next return the first airing time at or after the given time
+// Use In on the returned time to convert it to another time zone
+// A BroadcastTime without Location, like the zero value, is treated as JST
+func (ths BroadcastTime) Next(after time.Time) time.Time {
+	location := ths.Location
+	if location == nil {
+		location = broadcastLocations["JST"]
+	}
+
+	local := after.In(location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+
+	days := (int(ths.Weekday) - int(local.Weekday()) + 7) % 7
+	next := midnight.AddDate(0, 0, days).Add(ths.TimeOfDay)
+	if next.Before(after) {
+		next = next.AddDate(0, 0, 7)
+	}
+
+	return next
+}
+
+// BroadcastTime return the parsed Broadcast of the anime
+func (ths Anime) BroadcastTime() (BroadcastTime, error) {
+	return ParseBroadcast(ths.Broadcast)
+}
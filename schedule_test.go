@@ -0,0 +1,242 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestScheduleEndpoints(t *testing.T) {
+	Convey("Testing Schedule Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		Convey("Testing GetSchedule Method", func() {
+			expectedSchedule := Schedule{
+				Monday: []SeasonAnime{
+					SeasonAnime{
+						MalID:    38524,
+						URL:      "https://myanimelist.net/anime/38524/Shingeki_no_Kyojin_Season_3_Part_2",
+						Title:    "Shingeki no Kyojin Season 3 Part 2",
+						Type:     "TV",
+						Episodes: 10,
+						Source:   "Manga",
+						Score:    9.1,
+					},
+				},
+			}
+
+			expectedScheduleBytes, err := json.Marshal(expectedSchedule)
+			So(err, ShouldBeNil)
+
+			Convey("GetSchedule should return a Schedule given valid day", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedScheduleBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/schedule/monday")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				schedule, err := jikan.GetSchedule(WeekdayMonday)
+
+				So(schedule, ShouldResemble, expectedSchedule)
+				So(len(schedule.Monday), ShouldEqual, 1)
+				So(schedule.Day(WeekdayMonday), ShouldResemble, expectedSchedule.Monday)
+				So(schedule.Day(WeekdayTuesday), ShouldBeEmpty)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetSchedule should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				schedule, err := jikan.GetSchedule(WeekdayMonday)
+
+				So(schedule, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetSchedule should return ResourceNotFoundError given unknown day", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				schedule, err := jikan.GetSchedule(WeekdayMonday)
+
+				So(schedule, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetSchedule should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				schedule, err := jikan.GetSchedule(WeekdayMonday)
+
+				So(schedule, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetSchedule should request the whole week given empty day", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedScheduleBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/schedule")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				schedule, err := jikan.GetSchedule("")
+
+				So(schedule, ShouldResemble, expectedSchedule)
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestScheduleDay(t *testing.T) {
+	Convey("Testing Schedule Day Method", t, func() {
+		anime := []SeasonAnime{SeasonAnime{MalID: 1}}
+
+		Convey("Day should return the anime of every weekday bucket", func() {
+			schedules := map[Weekday]Schedule{
+				WeekdayMonday:    Schedule{Monday: anime},
+				WeekdayTuesday:   Schedule{Tuesday: anime},
+				WeekdayWednesday: Schedule{Wednesday: anime},
+				WeekdayThursday:  Schedule{Thursday: anime},
+				WeekdayFriday:    Schedule{Friday: anime},
+				WeekdaySaturday:  Schedule{Saturday: anime},
+				WeekdaySunday:    Schedule{Sunday: anime},
+				WeekdayOther:     Schedule{Other: anime},
+				WeekdayUnknown:   Schedule{Unknown: anime},
+			}
+
+			for day, schedule := range schedules {
+				So(schedule.Day(day), ShouldResemble, anime)
+			}
+		})
+
+		Convey("Day should return nil given unknown weekday", func() {
+			So(Schedule{Monday: anime}.Day("someday"), ShouldBeNil)
+		})
+	})
+}
+
+func TestParseBroadcast(t *testing.T) {
+	Convey("Testing ParseBroadcast Function", t, func() {
+		Convey("ParseBroadcast should return BroadcastTime given weekly broadcast", func() {
+			broadcastTime, err := ParseBroadcast("Saturdays at 01:00 (JST)")
+
+			So(err, ShouldBeNil)
+			So(broadcastTime.Weekday, ShouldEqual, time.Saturday)
+			So(broadcastTime.TimeOfDay, ShouldEqual, time.Hour)
+			So(broadcastTime.Location.String(), ShouldEqual, "JST")
+		})
+
+		Convey("ParseBroadcast should parse every weekday", func() {
+			broadcastTime, err := ParseBroadcast("Wednesdays at 23:30 (JST)")
+
+			So(err, ShouldBeNil)
+			So(broadcastTime.Weekday, ShouldEqual, time.Wednesday)
+			So(broadcastTime.TimeOfDay, ShouldEqual, 23*time.Hour+30*time.Minute)
+		})
+
+		Convey("ParseBroadcast should return InvalidBroadcastError given irregular broadcast", func() {
+			for _, broadcast := range []string{"", "Unknown", "Not scheduled once per week", "Sundays at Unknown", "Sundays at 25:00 (JST)", "Sundays at 01:00 (PST)"} {
+				broadcastTime, err := ParseBroadcast(broadcast)
+
+				So(broadcastTime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, InvalidBroadcastError)
+			}
+		})
+
+		Convey("Anime BroadcastTime should parse the anime's broadcast", func() {
+			broadcastTime, err := Anime{Broadcast: "Sundays at 17:00 (JST)"}.BroadcastTime()
+
+			So(err, ShouldBeNil)
+			So(broadcastTime.Weekday, ShouldEqual, time.Sunday)
+		})
+	})
+}
+
+func TestBroadcastTimeNext(t *testing.T) {
+	Convey("Testing BroadcastTime Next Method", t, func() {
+		broadcastTime, err := ParseBroadcast("Saturdays at 01:00 (JST)")
+		So(err, ShouldBeNil)
+
+		Convey("Next should return the upcoming airing time in the broadcast location", func() {
+			// Wednesday, 2020-10-14 12:00 UTC is Wednesday 21:00 JST
+			next := broadcastTime.Next(time.Date(2020, time.October, 14, 12, 0, 0, 0, time.UTC))
+
+			So(next.Weekday(), ShouldEqual, time.Saturday)
+			So(next.Equal(time.Date(2020, time.October, 16, 16, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		})
+
+		Convey("Next should return the given time when it is exactly the airing time", func() {
+			airing := time.Date(2020, time.October, 16, 16, 0, 0, 0, time.UTC)
+
+			So(broadcastTime.Next(airing).Equal(airing), ShouldBeTrue)
+		})
+
+		Convey("Next should return the airing time of next week when this week's has passed", func() {
+			next := broadcastTime.Next(time.Date(2020, time.October, 16, 16, 1, 0, 0, time.UTC))
+
+			So(next.Equal(time.Date(2020, time.October, 23, 16, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		})
+
+		Convey("Next should be convertible to other time zone", func() {
+			next := broadcastTime.Next(time.Date(2020, time.October, 14, 12, 0, 0, 0, time.UTC))
+			local := next.In(time.FixedZone("WIB", 7*60*60))
+
+			So(local.Weekday(), ShouldEqual, time.Friday)
+			So(local.Hour(), ShouldEqual, 23)
+		})
+
+		Convey("Next should treat the zero value returned with an error as JST", func() {
+			broadcastTime, err := ParseBroadcast("Unknown")
+			So(err, ShouldNotBeNil)
+			So(broadcastTime, ShouldBeZeroValue)
+
+			// Wednesday, 2020-10-14 12:00 UTC is Wednesday 21:00 JST, the next Sunday 00:00 JST is Saturday 15:00 UTC
+			next := broadcastTime.Next(time.Date(2020, time.October, 14, 12, 0, 0, 0, time.UTC))
+
+			So(next.Equal(time.Date(2020, time.October, 17, 15, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,323 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SearchSortAscending sorts search results in ascending order
+	SearchSortAscending = "asc"
+
+	// SearchSortDescending sorts search results in descending order
+	SearchSortDescending = "desc"
+)
+
+// SearchQuery is a builder of query parameters for search endpoints
+// Every setter returns the same SearchQuery so calls can be chained
+type SearchQuery struct {
+	params url.Values
+}
+
+// NewSearchQuery return a SearchQuery with the given search keyword
+// Put empty string in q parameter if don't want to search by keyword
+func NewSearchQuery(q string) *SearchQuery {
+	query := &SearchQuery{}
+	return query.setString("q", q)
+}
+
+// Page sets the page of the search results
+func (ths *SearchQuery) Page(page int) *SearchQuery {
+	return ths.setInt("page", page)
+}
+
+// Type sets the type filter, e.g. tv, movie, ova for anime or manga, novel, oneshot for manga
+func (ths *SearchQuery) Type(t string) *SearchQuery {
+	return ths.setString("type", t)
+}
+
+// Status sets the status filter, e.g. airing, completed, to_be_aired for anime
+// or publishing, completed, to_be_published for manga
+func (ths *SearchQuery) Status(status string) *SearchQuery {
+	return ths.setString("status", status)
+}
+
+// Rated sets the age rating filter, e.g. g, pg, pg13, r17, r, rx
+func (ths *SearchQuery) Rated(rated string) *SearchQuery {
+	return ths.setString("rated", rated)
+}
+
+// Genre includes only results having the given genre IDs
+// It replaces any genres set before by Genre or ExcludeGenre
+func (ths *SearchQuery) Genre(ids ...int) *SearchQuery {
+	ths.setString("genre_exclude", "")
+	return ths.setInts("genre", ids)
+}
+
+// ExcludeGenre excludes results having the given genre IDs
+// It replaces any genres set before by Genre or ExcludeGenre
+func (ths *SearchQuery) ExcludeGenre(ids ...int) *SearchQuery {
+	ths.setString("genre_exclude", "1")
+	return ths.setInts("genre", ids)
+}
+
+// Score sets the minimum score of the results
+func (ths *SearchQuery) Score(score float64) *SearchQuery {
+	if score <= 0 {
+		return ths.setString("score", "")
+	}
+
+	return ths.setString("score", strconv.FormatFloat(score, 'f', -1, 64))
+}
+
+// StartDate sets the minimum start date of the results
+func (ths *SearchQuery) StartDate(date time.Time) *SearchQuery {
+	return ths.setDate("start_date", date)
+}
+
+// EndDate sets the maximum end date of the results
+func (ths *SearchQuery) EndDate(date time.Time) *SearchQuery {
+	return ths.setDate("end_date", date)
+}
+
+// Producer sets the anime producer filter by its MalID
+func (ths *SearchQuery) Producer(id int) *SearchQuery {
+	return ths.setInt("producer", id)
+}
+
+// Magazine sets the manga magazine filter by its MalID
+func (ths *SearchQuery) Magazine(id int) *SearchQuery {
+	return ths.setInt("magazine", id)
+}
+
+// OrderBy sets the field to order the results by, e.g. title, start_date, score, members
+func (ths *SearchQuery) OrderBy(field string) *SearchQuery {
+	return ths.setString("order_by", field)
+}
+
+// Sort sets the order direction, use SearchSortAscending or SearchSortDescending
+func (ths *SearchQuery) Sort(sort string) *SearchQuery {
+	return ths.setString("sort", sort)
+}
+
+// Letter sets the starting letter of the results' title or name
+func (ths *SearchQuery) Letter(letter string) *SearchQuery {
+	return ths.setString("letter", letter)
+}
+
+// Limit sets the maximum number of results per page
+func (ths *SearchQuery) Limit(limit int) *SearchQuery {
+	return ths.setInt("limit", limit)
+}
+
+// Values return the query parameters built by the SearchQuery
+func (ths *SearchQuery) Values() url.Values {
+	values := url.Values{}
+	if ths == nil {
+		return values
+	}
+
+	for key, value := range ths.params {
+		values[key] = append([]string(nil), value...)
+	}
+
+	return values
+}
+
+func (ths *SearchQuery) setString(key, value string) *SearchQuery {
+	if value == "" {
+		ths.params.Del(key)
+		return ths
+	}
+
+	if ths.params == nil {
+		ths.params = url.Values{}
+	}
+
+	ths.params.Set(key, value)
+	return ths
+}
+
+func (ths *SearchQuery) setInt(key string, value int) *SearchQuery {
+	if value <= 0 {
+		return ths.setString(key, "")
+	}
+
+	return ths.setString(key, strconv.Itoa(value))
+}
+
+func (ths *SearchQuery) setInts(key string, values []int) *SearchQuery {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, strconv.Itoa(value))
+	}
+
+	return ths.setString(key, strings.Join(ids, ","))
+}
+
+func (ths *SearchQuery) setDate(key string, date time.Time) *SearchQuery {
+	if date.IsZero() {
+		return ths.setString(key, "")
+	}
+
+	return ths.setString(key, date.Format("2006-01-02"))
+}
+
+func (ths *jikanClient) searchURL(searchType string, query *SearchQuery) string {
+	url := fmt.Sprintf("%s/search/%s", ths.baseURL, searchType)
+	if params := query.Values().Encode(); params != "" {
+		url = fmt.Sprintf("%s?%s", url, params)
+	}
+
+	return url
+}
+
+// ===================================================================================================================================
+
+// AnimeSearch is a struct of anime search results with pagination
+type AnimeSearch struct {
+	ResponseMeta
+
+	Results  []AnimeSearchResult `json:"results"`
+	LastPage int                 `json:"last_page"`
+}
+
+// AnimeSearchResult is a struct details of an anime in search results
+type AnimeSearchResult struct {
+	MalID     int       `json:"mal_id"`
+	URL       string    `json:"url"`
+	ImageURL  string    `json:"image_url"`
+	Title     string    `json:"title"`
+	Airing    bool      `json:"airing"`
+	Synopsis  string    `json:"synopsis"`
+	Type      string    `json:"type"`
+	Episodes  int       `json:"episodes"`
+	Score     float64   `json:"score"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Members   int       `json:"members"`
+	Rated     string    `json:"rated"`
+}
+
+// SearchAnime return anime matching the given query
+// Put nil in query parameter if don't want to use any filter
+func (ths *jikanClient) SearchAnime(query *SearchQuery) (animeSearch AnimeSearch, err error) {
+	return ths.SearchAnimeContext(context.Background(), query)
+}
+
+func (ths *jikanClient) SearchAnimeContext(ctx context.Context, query *SearchQuery) (animeSearch AnimeSearch, err error) {
+	url := ths.searchURL("anime", query)
+
+	err = ths.get(ctx, url, &animeSearch)
+	return
+}
+
+// ===================================================================================================================================
+
+// MangaSearch is a struct of manga search results with pagination
+type MangaSearch struct {
+	ResponseMeta
+
+	Results  []MangaSearchResult `json:"results"`
+	LastPage int                 `json:"last_page"`
+}
+
+// MangaSearchResult is a struct details of a manga in search results
+type MangaSearchResult struct {
+	MalID      int       `json:"mal_id"`
+	URL        string    `json:"url"`
+	ImageURL   string    `json:"image_url"`
+	Title      string    `json:"title"`
+	Publishing bool      `json:"publishing"`
+	Synopsis   string    `json:"synopsis"`
+	Type       string    `json:"type"`
+	Chapters   int       `json:"chapters"`
+	Volumes    int       `json:"volumes"`
+	Score      float64   `json:"score"`
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	Members    int       `json:"members"`
+}
+
+// SearchManga return manga matching the given query
+// Put nil in query parameter if don't want to use any filter
+func (ths *jikanClient) SearchManga(query *SearchQuery) (mangaSearch MangaSearch, err error) {
+	return ths.SearchMangaContext(context.Background(), query)
+}
+
+func (ths *jikanClient) SearchMangaContext(ctx context.Context, query *SearchQuery) (mangaSearch MangaSearch, err error) {
+	url := ths.searchURL("manga", query)
+
+	err = ths.get(ctx, url, &mangaSearch)
+	return
+}
+
+// ===================================================================================================================================
+
+// PeopleSearch is a struct of people search results with pagination
+type PeopleSearch struct {
+	ResponseMeta
+
+	Results  []PersonSearchResult `json:"results"`
+	LastPage int                  `json:"last_page"`
+}
+
+// PersonSearchResult is a struct details of a person in search results
+type PersonSearchResult struct {
+	MalID            int      `json:"mal_id"`
+	URL              string   `json:"url"`
+	ImageURL         string   `json:"image_url"`
+	Name             string   `json:"name"`
+	AlternativeNames []string `json:"alternative_names"`
+}
+
+// SearchPeople return people matching the given query
+// Put nil in query parameter if don't want to use any filter
+func (ths *jikanClient) SearchPeople(query *SearchQuery) (peopleSearch PeopleSearch, err error) {
+	return ths.SearchPeopleContext(context.Background(), query)
+}
+
+func (ths *jikanClient) SearchPeopleContext(ctx context.Context, query *SearchQuery) (peopleSearch PeopleSearch, err error) {
+	url := ths.searchURL("person", query)
+
+	err = ths.get(ctx, url, &peopleSearch)
+	return
+}
+
+// ===================================================================================================================================
+
+// CharacterSearch is a struct of character search results with pagination
+type CharacterSearch struct {
+	ResponseMeta
+
+	Results  []CharacterSearchResult `json:"results"`
+	LastPage int                     `json:"last_page"`
+}
+
+// CharacterSearchResult is a struct details of a character in search results
+type CharacterSearchResult struct {
+	MalID            int             `json:"mal_id"`
+	URL              string          `json:"url"`
+	ImageURL         string          `json:"image_url"`
+	Name             string          `json:"name"`
+	AlternativeNames []string        `json:"alternative_names"`
+	Anime            []AnimeResource `json:"anime"`
+	Manga            []AnimeResource `json:"manga"`
+}
+
+// SearchCharacters return characters matching the given query
+// Put nil in query parameter if don't want to use any filter
+func (ths *jikanClient) SearchCharacters(query *SearchQuery) (characterSearch CharacterSearch, err error) {
+	return ths.SearchCharactersContext(context.Background(), query)
+}
+
+func (ths *jikanClient) SearchCharactersContext(ctx context.Context, query *SearchQuery) (characterSearch CharacterSearch, err error) {
+	url := ths.searchURL("character", query)
+
+	err = ths.get(ctx, url, &characterSearch)
+	return
+}
@@ -0,0 +1,464 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSearchEndpoints(t *testing.T) {
+	Convey("Testing Search Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+		query := NewSearchQuery("bebop").Page(2)
+
+		Convey("Testing SearchAnime Method", func() {
+			expectedAnimeSearch := AnimeSearch{
+				Results: []AnimeSearchResult{
+					AnimeSearchResult{
+						MalID:    1,
+						URL:      "https://myanimelist.net/anime/1/Cowboy_Bebop",
+						Title:    "Cowboy Bebop",
+						Type:     "TV",
+						Episodes: 26,
+						Score:    8.78,
+						Members:  1400000,
+						Rated:    "R",
+					},
+				},
+				LastPage: 20,
+			}
+
+			expectedAnimeSearchBytes, err := json.Marshal(expectedAnimeSearch)
+			So(err, ShouldBeNil)
+
+			Convey("SearchAnime should return an AnimeSearch given valid query", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedAnimeSearchBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/search/anime")
+						So(req.URL.RawQuery, ShouldEqual, "page=2&q=bebop")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeSearch, err := jikan.SearchAnime(query)
+
+				So(animeSearch, ShouldResemble, expectedAnimeSearch)
+				So(animeSearch.LastPage, ShouldEqual, 20)
+				So(animeSearch.Results[0].Title, ShouldEqual, "Cowboy Bebop")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("SearchAnime should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				animeSearch, err := jikan.SearchAnime(query)
+
+				So(animeSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("SearchAnime should return ResourceNotFoundError given query without results", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				animeSearch, err := jikan.SearchAnime(nil)
+
+				So(animeSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("SearchAnime should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeSearch, err := jikan.SearchAnime(nil)
+
+				So(animeSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing SearchManga Method", func() {
+			expectedMangaSearch := MangaSearch{
+				Results: []MangaSearchResult{
+					MangaSearchResult{
+						MalID:      1,
+						URL:        "https://myanimelist.net/manga/1/Monster",
+						Title:      "Monster",
+						Publishing: false,
+						Type:       "Manga",
+						Chapters:   162,
+						Volumes:    18,
+						Score:      9.11,
+					},
+				},
+				LastPage: 1,
+			}
+
+			expectedMangaSearchBytes, err := json.Marshal(expectedMangaSearch)
+			So(err, ShouldBeNil)
+
+			Convey("SearchManga should return a MangaSearch given valid query", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedMangaSearchBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/search/manga")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaSearch, err := jikan.SearchManga(query)
+
+				So(mangaSearch, ShouldResemble, expectedMangaSearch)
+				So(mangaSearch.LastPage, ShouldEqual, 1)
+				So(mangaSearch.Results[0].Volumes, ShouldEqual, 18)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("SearchManga should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				mangaSearch, err := jikan.SearchManga(query)
+
+				So(mangaSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("SearchManga should return ResourceNotFoundError given query without results", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				mangaSearch, err := jikan.SearchManga(nil)
+
+				So(mangaSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("SearchManga should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				mangaSearch, err := jikan.SearchManga(nil)
+
+				So(mangaSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing SearchPeople Method", func() {
+			expectedPeopleSearch := PeopleSearch{
+				Results: []PersonSearchResult{
+					PersonSearchResult{
+						MalID:            11,
+						URL:              "https://myanimelist.net/people/11/Kouichi_Yamadera",
+						Name:             "Kouichi Yamadera",
+						AlternativeNames: []string{"Kōichi Yamadera"},
+					},
+				},
+				LastPage: 1,
+			}
+
+			expectedPeopleSearchBytes, err := json.Marshal(expectedPeopleSearch)
+			So(err, ShouldBeNil)
+
+			Convey("SearchPeople should return a PeopleSearch given valid query", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedPeopleSearchBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/search/person")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				peopleSearch, err := jikan.SearchPeople(query)
+
+				So(peopleSearch, ShouldResemble, expectedPeopleSearch)
+				So(peopleSearch.Results[0].Name, ShouldEqual, "Kouichi Yamadera")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("SearchPeople should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				peopleSearch, err := jikan.SearchPeople(query)
+
+				So(peopleSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("SearchPeople should return ResourceNotFoundError given query without results", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				peopleSearch, err := jikan.SearchPeople(nil)
+
+				So(peopleSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("SearchPeople should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				peopleSearch, err := jikan.SearchPeople(nil)
+
+				So(peopleSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing SearchCharacters Method", func() {
+			expectedCharacterSearch := CharacterSearch{
+				Results: []CharacterSearchResult{
+					CharacterSearchResult{
+						MalID: 1,
+						URL:   "https://myanimelist.net/character/1/Spike_Spiegel",
+						Name:  "Spiegel, Spike",
+						Anime: []AnimeResource{
+							AnimeResource{
+								MalID: 1,
+								Type:  "anime",
+								Name:  "Cowboy Bebop",
+								URL:   "https://myanimelist.net/anime/1/Cowboy_Bebop",
+							},
+						},
+					},
+				},
+				LastPage: 1,
+			}
+
+			expectedCharacterSearchBytes, err := json.Marshal(expectedCharacterSearch)
+			So(err, ShouldBeNil)
+
+			Convey("SearchCharacters should return a CharacterSearch given valid query", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedCharacterSearchBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/search/character")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				characterSearch, err := jikan.SearchCharacters(query)
+
+				So(characterSearch, ShouldResemble, expectedCharacterSearch)
+				So(characterSearch.Results[0].Anime[0].MalID, ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("SearchCharacters should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				characterSearch, err := jikan.SearchCharacters(query)
+
+				So(characterSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("SearchCharacters should return ResourceNotFoundError given query without results", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				characterSearch, err := jikan.SearchCharacters(nil)
+
+				So(characterSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("SearchCharacters should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				characterSearch, err := jikan.SearchCharacters(nil)
+
+				So(characterSearch, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSearchQuery(t *testing.T) {
+	Convey("Testing SearchQuery Builder", t, func() {
+		Convey("NewSearchQuery should only set q when the keyword is not empty", func() {
+			So(NewSearchQuery("").Values().Encode(), ShouldEqual, "")
+			So(NewSearchQuery("bebop").Values().Encode(), ShouldEqual, "q=bebop")
+		})
+
+		Convey("SearchQuery should encode every filter", func() {
+			query := NewSearchQuery("naruto").
+				Page(2).
+				Type("tv").
+				Status("completed").
+				Rated("pg13").
+				Genre(1, 2).
+				Score(7.5).
+				StartDate(time.Date(2002, time.October, 3, 0, 0, 0, 0, time.UTC)).
+				EndDate(time.Date(2007, time.February, 8, 0, 0, 0, 0, time.UTC)).
+				Producer(1).
+				Magazine(2).
+				OrderBy("score").
+				Sort(SearchSortDescending).
+				Letter("N").
+				Limit(10)
+
+			values := query.Values()
+
+			So(values.Get("q"), ShouldEqual, "naruto")
+			So(values.Get("page"), ShouldEqual, "2")
+			So(values.Get("type"), ShouldEqual, "tv")
+			So(values.Get("status"), ShouldEqual, "completed")
+			So(values.Get("rated"), ShouldEqual, "pg13")
+			So(values.Get("genre"), ShouldEqual, "1,2")
+			So(values.Get("genre_exclude"), ShouldEqual, "")
+			So(values.Get("score"), ShouldEqual, "7.5")
+			So(values.Get("start_date"), ShouldEqual, "2002-10-03")
+			So(values.Get("end_date"), ShouldEqual, "2007-02-08")
+			So(values.Get("producer"), ShouldEqual, "1")
+			So(values.Get("magazine"), ShouldEqual, "2")
+			So(values.Get("order_by"), ShouldEqual, "score")
+			So(values.Get("sort"), ShouldEqual, "desc")
+			So(values.Get("letter"), ShouldEqual, "N")
+			So(values.Get("limit"), ShouldEqual, "10")
+		})
+
+		Convey("ExcludeGenre should replace included genres and set genre_exclude", func() {
+			values := NewSearchQuery("").Genre(1).ExcludeGenre(12).Values()
+
+			So(values.Get("genre"), ShouldEqual, "12")
+			So(values.Get("genre_exclude"), ShouldEqual, "1")
+
+			values = NewSearchQuery("").ExcludeGenre(12).Genre(1).Values()
+
+			So(values.Get("genre"), ShouldEqual, "1")
+			So(values.Get("genre_exclude"), ShouldEqual, "")
+		})
+
+		Convey("Zero values should remove the filter", func() {
+			values := NewSearchQuery("bebop").Page(2).Page(0).Type("tv").Type("").Values()
+
+			So(values.Encode(), ShouldEqual, "q=bebop")
+		})
+
+		Convey("Values should not expose the internal state of the query", func() {
+			query := NewSearchQuery("bebop")
+			query.Values().Set("q", "changed")
+
+			So(query.Values().Get("q"), ShouldEqual, "bebop")
+		})
+
+		Convey("Values on nil or zero SearchQuery should return empty values", func() {
+			var query *SearchQuery
+
+			So(query.Values(), ShouldBeEmpty)
+			So((&SearchQuery{}).Page(1).Values().Get("page"), ShouldEqual, "1")
+		})
+	})
+}
@@ -0,0 +1,137 @@
+package gojikan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Season is a type of anime airing season in a year
+type Season string
+
+const (
+	// SeasonWinter is the season from January to March
+	SeasonWinter Season = "winter"
+
+	// SeasonSpring is the season from April to June
+	SeasonSpring Season = "spring"
+
+	// SeasonSummer is the season from July to September
+	SeasonSummer Season = "summer"
+
+	// SeasonFall is the season from October to December
+	SeasonFall Season = "fall"
+)
+
+// UnmarshalJSON accepts season names in any letter case like "Fall" returned by Jikan
+func (ths *Season) UnmarshalJSON(data []byte) error {
+	var season string
+	if err := json.Unmarshal(data, &season); err != nil {
+		return err
+	}
+
+	*ths = Season(strings.ToLower(season))
+	return nil
+}
+
+// CurrentSeason return the year and the anime season of the given time
+func CurrentSeason(t time.Time) (year int, season Season) {
+	year = t.Year()
+
+	switch t.Month() {
+	case time.January, time.February, time.March:
+		season = SeasonWinter
+	case time.April, time.May, time.June:
+		season = SeasonSpring
+	case time.July, time.August, time.September:
+		season = SeasonSummer
+	default:
+		season = SeasonFall
+	}
+
+	return
+}
+
+// AnimeSeason is a struct of anime airing in a season
+type AnimeSeason struct {
+	ResponseMeta
+
+	SeasonName string        `json:"season_name"`
+	SeasonYear int           `json:"season_year"`
+	Anime      []SeasonAnime `json:"anime"`
+}
+
+// SeasonAnime is a struct details of an anime airing in a season
+type SeasonAnime struct {
+	MalID       int             `json:"mal_id"`
+	URL         string          `json:"url"`
+	Title       string          `json:"title"`
+	ImageURL    string          `json:"image_url"`
+	Synopsis    string          `json:"synopsis"`
+	Type        string          `json:"type"`
+	AiringStart time.Time       `json:"airing_start"`
+	Episodes    int             `json:"episodes"`
+	Members     int             `json:"members"`
+	Genres      []AnimeResource `json:"genres"`
+	Source      string          `json:"source"`
+	Producers   []AnimeResource `json:"producers"`
+	Score       float64         `json:"score"`
+	Licensors   []string        `json:"licensors"`
+	R18         bool            `json:"r18"`
+	Kids        bool            `json:"kids"`
+	Continuing  bool            `json:"continuing"`
+}
+
+func (ths *jikanClient) GetSeason(year int, season Season) (animeSeason AnimeSeason, err error) {
+	return ths.GetSeasonContext(context.Background(), year, season)
+}
+
+func (ths *jikanClient) GetSeasonContext(ctx context.Context, year int, season Season) (animeSeason AnimeSeason, err error) {
+	url := fmt.Sprintf("%s/season/%d/%s", ths.baseURL, year, season)
+
+	err = ths.get(ctx, url, &animeSeason)
+	return
+}
+
+// ===================================================================================================================================
+
+// SeasonArchive is a struct list of every year and its seasons available in MyAnimeList
+type SeasonArchive struct {
+	ResponseMeta
+
+	Archive []SeasonArchiveYear `json:"archive"`
+}
+
+// SeasonArchiveYear is a struct of available seasons in a year
+type SeasonArchiveYear struct {
+	Year    int      `json:"year"`
+	Seasons []Season `json:"seasons"`
+}
+
+func (ths *jikanClient) GetSeasonArchive() (seasonArchive SeasonArchive, err error) {
+	return ths.GetSeasonArchiveContext(context.Background())
+}
+
+func (ths *jikanClient) GetSeasonArchiveContext(ctx context.Context) (seasonArchive SeasonArchive, err error) {
+	url := fmt.Sprintf("%s/season/archive", ths.baseURL)
+
+	err = ths.get(ctx, url, &seasonArchive)
+	return
+}
+
+// ===================================================================================================================================
+
+// GetSeasonLater return anime announced to air after the upcoming season
+// The returned SeasonYear is always 0 because the airing year is not yet known
+func (ths *jikanClient) GetSeasonLater() (animeSeason AnimeSeason, err error) {
+	return ths.GetSeasonLaterContext(context.Background())
+}
+
+func (ths *jikanClient) GetSeasonLaterContext(ctx context.Context) (animeSeason AnimeSeason, err error) {
+	url := fmt.Sprintf("%s/season/later", ths.baseURL)
+
+	err = ths.get(ctx, url, &animeSeason)
+	return
+}
@@ -0,0 +1,356 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSeasonEndpoints(t *testing.T) {
+	Convey("Testing Season Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		Convey("Testing GetSeason Method", func() {
+			expectedAnimeSeason := AnimeSeason{
+				SeasonName: "Spring",
+				SeasonYear: 2018,
+				Anime: []SeasonAnime{
+					SeasonAnime{
+						MalID:       35760,
+						URL:         "https://myanimelist.net/anime/35760/Shingeki_no_Kyojin_Season_3",
+						Title:       "Shingeki no Kyojin Season 3",
+						Type:        "TV",
+						AiringStart: time.Date(2018, time.July, 22, 15, 10, 0, 0, time.UTC),
+						Episodes:    12,
+						Members:     1000000,
+						Genres: []AnimeResource{
+							AnimeResource{
+								MalID: 1,
+								Type:  "anime",
+								Name:  "Action",
+								URL:   "https://myanimelist.net/anime/genre/1/Action",
+							},
+						},
+						Source:    "Manga",
+						Score:     8.7,
+						Licensors: []string{"Funimation"},
+					},
+				},
+			}
+
+			expectedAnimeSeasonBytes, err := json.Marshal(expectedAnimeSeason)
+			So(err, ShouldBeNil)
+
+			Convey("GetSeason should return an AnimeSeason given valid year and season", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedAnimeSeasonBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/season/2018/spring")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeSeason, err := jikan.GetSeason(2018, SeasonSpring)
+
+				So(animeSeason, ShouldResemble, expectedAnimeSeason)
+				So(animeSeason.SeasonYear, ShouldEqual, 2018)
+				So(animeSeason.Anime[0].Genres[0].Name, ShouldEqual, "Action")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetSeason should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				animeSeason, err := jikan.GetSeason(2018, SeasonSpring)
+
+				So(animeSeason, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetSeason should return ResourceNotFoundError given unknown year", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				animeSeason, err := jikan.GetSeason(0, SeasonSpring)
+
+				So(animeSeason, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetSeason should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeSeason, err := jikan.GetSeason(0, SeasonSpring)
+
+				So(animeSeason, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetSeasonArchive Method", func() {
+			expectedSeasonArchive := SeasonArchive{
+				Archive: []SeasonArchiveYear{
+					SeasonArchiveYear{
+						Year:    2018,
+						Seasons: []Season{SeasonFall, SeasonSummer, SeasonSpring, SeasonWinter},
+					},
+				},
+			}
+
+			expectedSeasonArchiveBytes, err := json.Marshal(expectedSeasonArchive)
+			So(err, ShouldBeNil)
+
+			Convey("GetSeasonArchive should return a SeasonArchive given valid request", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedSeasonArchiveBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/season/archive")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				seasonArchive, err := jikan.GetSeasonArchive()
+
+				So(seasonArchive, ShouldResemble, expectedSeasonArchive)
+				So(seasonArchive.Archive[0].Year, ShouldEqual, 2018)
+				So(seasonArchive.Archive[0].Seasons[0], ShouldEqual, SeasonFall)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetSeasonArchive should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				seasonArchive, err := jikan.GetSeasonArchive()
+
+				So(seasonArchive, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetSeasonArchive should return ResourceNotFoundError given unknown resource", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				seasonArchive, err := jikan.GetSeasonArchive()
+
+				So(seasonArchive, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetSeasonArchive should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				seasonArchive, err := jikan.GetSeasonArchive()
+
+				So(seasonArchive, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetSeasonArchive should accept capitalized season names", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte(`{"archive":[{"year":2018,"seasons":["Fall","Summer"]}]}`)))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				seasonArchive, err := jikan.GetSeasonArchive()
+
+				So(seasonArchive.Archive[0].Seasons, ShouldResemble, []Season{SeasonFall, SeasonSummer})
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("Testing GetSeasonLater Method", func() {
+			expectedAnimeSeason := AnimeSeason{
+				SeasonName: "Later",
+				SeasonYear: 0,
+				Anime: []SeasonAnime{
+					SeasonAnime{
+						MalID:       35760,
+						URL:         "https://myanimelist.net/anime/35760/Shingeki_no_Kyojin_Season_3",
+						Title:       "Shingeki no Kyojin Season 3",
+						Type:        "TV",
+						AiringStart: time.Date(2018, time.July, 22, 15, 10, 0, 0, time.UTC),
+						Episodes:    12,
+						Members:     1000000,
+						Genres: []AnimeResource{
+							AnimeResource{
+								MalID: 1,
+								Type:  "anime",
+								Name:  "Action",
+								URL:   "https://myanimelist.net/anime/genre/1/Action",
+							},
+						},
+						Source:    "Manga",
+						Score:     8.7,
+						Licensors: []string{"Funimation"},
+					},
+				},
+			}
+
+			expectedAnimeSeasonBytes, err := json.Marshal(expectedAnimeSeason)
+			So(err, ShouldBeNil)
+
+			Convey("GetSeasonLater should return an AnimeSeason given valid request", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedAnimeSeasonBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/season/later")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeSeason, err := jikan.GetSeasonLater()
+
+				So(animeSeason, ShouldResemble, expectedAnimeSeason)
+				So(animeSeason.SeasonName, ShouldEqual, "Later")
+				So(animeSeason.SeasonYear, ShouldEqual, 0)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetSeasonLater should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				animeSeason, err := jikan.GetSeasonLater()
+
+				So(animeSeason, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetSeasonLater should return ResourceNotFoundError given unknown resource", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				animeSeason, err := jikan.GetSeasonLater()
+
+				So(animeSeason, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetSeasonLater should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				animeSeason, err := jikan.GetSeasonLater()
+
+				So(animeSeason, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestCurrentSeason(t *testing.T) {
+	Convey("Testing CurrentSeason Function", t, func() {
+		Convey("CurrentSeason should return the season of every month", func() {
+			expectedSeasons := map[time.Month]Season{
+				time.January:   SeasonWinter,
+				time.February:  SeasonWinter,
+				time.March:     SeasonWinter,
+				time.April:     SeasonSpring,
+				time.May:       SeasonSpring,
+				time.June:      SeasonSpring,
+				time.July:      SeasonSummer,
+				time.August:    SeasonSummer,
+				time.September: SeasonSummer,
+				time.October:   SeasonFall,
+				time.November:  SeasonFall,
+				time.December:  SeasonFall,
+			}
+
+			for month, expectedSeason := range expectedSeasons {
+				year, season := CurrentSeason(time.Date(2020, month, 15, 0, 0, 0, 0, time.UTC))
+
+				So(year, ShouldEqual, 2020)
+				So(season, ShouldEqual, expectedSeason)
+			}
+		})
+	})
+}
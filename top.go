@@ -0,0 +1,225 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TopAnimeSubtype is a type of ranking filter for top anime
+type TopAnimeSubtype string
+
+const (
+	// TopAnimeAiring ranks anime that are currently airing
+	TopAnimeAiring TopAnimeSubtype = "airing"
+
+	// TopAnimeUpcoming ranks anime that are not yet aired
+	TopAnimeUpcoming TopAnimeSubtype = "upcoming"
+
+	// TopAnimeTV ranks TV anime
+	TopAnimeTV TopAnimeSubtype = "tv"
+
+	// TopAnimeMovie ranks anime movies
+	TopAnimeMovie TopAnimeSubtype = "movie"
+
+	// TopAnimeOVA ranks OVA anime
+	TopAnimeOVA TopAnimeSubtype = "ova"
+
+	// TopAnimeSpecial ranks special anime
+	TopAnimeSpecial TopAnimeSubtype = "special"
+
+	// TopAnimeByPopularity ranks anime by its members count
+	TopAnimeByPopularity TopAnimeSubtype = "bypopularity"
+
+	// TopAnimeFavorite ranks anime by its favorites count
+	TopAnimeFavorite TopAnimeSubtype = "favorite"
+)
+
+// TopMangaSubtype is a type of ranking filter for top manga
+type TopMangaSubtype string
+
+const (
+	// TopMangaManga ranks manga
+	TopMangaManga TopMangaSubtype = "manga"
+
+	// TopMangaNovels ranks light novels
+	TopMangaNovels TopMangaSubtype = "novels"
+
+	// TopMangaOneshots ranks one-shot manga
+	TopMangaOneshots TopMangaSubtype = "oneshots"
+
+	// TopMangaDoujin ranks doujinshi
+	TopMangaDoujin TopMangaSubtype = "doujin"
+
+	// TopMangaManhwa ranks korean comics
+	TopMangaManhwa TopMangaSubtype = "manhwa"
+
+	// TopMangaManhua ranks chinese comics
+	TopMangaManhua TopMangaSubtype = "manhua"
+
+	// TopMangaByPopularity ranks manga by its members count
+	TopMangaByPopularity TopMangaSubtype = "bypopularity"
+
+	// TopMangaFavorite ranks manga by its favorites count
+	TopMangaFavorite TopMangaSubtype = "favorite"
+)
+
+func (ths *jikanClient) topURL(topType string, page int, subtype string) string {
+	url := fmt.Sprintf("%s/top/%s", ths.baseURL, topType)
+
+	// subtype is the last path segment, so it needs an explicit page before it
+	if subtype != "" && page <= 0 {
+		page = 1
+	}
+
+	if page > 0 {
+		url = fmt.Sprintf("%s/%d", url, page)
+	}
+
+	if subtype != "" {
+		url = fmt.Sprintf("%s/%s", url, subtype)
+	}
+
+	return url
+}
+
+// TopAnime is a struct list of top ranked anime
+type TopAnime struct {
+	ResponseMeta
+
+	Top []TopAnimeItem `json:"top"`
+}
+
+// TopAnimeItem is a struct details of a ranked anime
+type TopAnimeItem struct {
+	MalID     int     `json:"mal_id"`
+	Rank      int     `json:"rank"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url"`
+	ImageURL  string  `json:"image_url"`
+	Type      string  `json:"type"`
+	Episodes  int     `json:"episodes"`
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	Members   int     `json:"members"`
+	Score     float64 `json:"score"`
+}
+
+// GetTopAnime return top ranked anime per page, maximum 50 anime per page
+// Put 0 in page parameter and empty string in subtype parameter if don't want to use them
+func (ths *jikanClient) GetTopAnime(page int, subtype TopAnimeSubtype) (topAnime TopAnime, err error) {
+	return ths.GetTopAnimeContext(context.Background(), page, subtype)
+}
+
+func (ths *jikanClient) GetTopAnimeContext(ctx context.Context, page int, subtype TopAnimeSubtype) (topAnime TopAnime, err error) {
+	url := ths.topURL("anime", page, string(subtype))
+
+	err = ths.get(ctx, url, &topAnime)
+	return
+}
+
+// ===================================================================================================================================
+
+// TopManga is a struct list of top ranked manga
+type TopManga struct {
+	ResponseMeta
+
+	Top []TopMangaItem `json:"top"`
+}
+
+// TopMangaItem is a struct details of a ranked manga
+type TopMangaItem struct {
+	MalID     int     `json:"mal_id"`
+	Rank      int     `json:"rank"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url"`
+	ImageURL  string  `json:"image_url"`
+	Type      string  `json:"type"`
+	Volumes   int     `json:"volumes"`
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	Members   int     `json:"members"`
+	Score     float64 `json:"score"`
+}
+
+// GetTopManga return top ranked manga per page, maximum 50 manga per page
+// Put 0 in page parameter and empty string in subtype parameter if don't want to use them
+func (ths *jikanClient) GetTopManga(page int, subtype TopMangaSubtype) (topManga TopManga, err error) {
+	return ths.GetTopMangaContext(context.Background(), page, subtype)
+}
+
+func (ths *jikanClient) GetTopMangaContext(ctx context.Context, page int, subtype TopMangaSubtype) (topManga TopManga, err error) {
+	url := ths.topURL("manga", page, string(subtype))
+
+	err = ths.get(ctx, url, &topManga)
+	return
+}
+
+// ===================================================================================================================================
+
+// TopPeople is a struct list of top ranked people
+type TopPeople struct {
+	ResponseMeta
+
+	Top []TopPerson `json:"top"`
+}
+
+// TopPerson is a struct details of a ranked person
+type TopPerson struct {
+	MalID     int       `json:"mal_id"`
+	Rank      int       `json:"rank"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	NameKanji string    `json:"name_kanji"`
+	ImageURL  string    `json:"image_url"`
+	Favorites int       `json:"favorites"`
+	Birthday  time.Time `json:"birthday"`
+}
+
+// GetTopPeople return top ranked people per page, maximum 50 people per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetTopPeople(page int) (topPeople TopPeople, err error) {
+	return ths.GetTopPeopleContext(context.Background(), page)
+}
+
+func (ths *jikanClient) GetTopPeopleContext(ctx context.Context, page int) (topPeople TopPeople, err error) {
+	url := ths.topURL("people", page, "")
+
+	err = ths.get(ctx, url, &topPeople)
+	return
+}
+
+// ===================================================================================================================================
+
+// TopCharacters is a struct list of top ranked characters
+type TopCharacters struct {
+	ResponseMeta
+
+	Top []TopCharacter `json:"top"`
+}
+
+// TopCharacter is a struct details of a ranked character
+type TopCharacter struct {
+	MalID        int             `json:"mal_id"`
+	Rank         int             `json:"rank"`
+	Title        string          `json:"title"`
+	URL          string          `json:"url"`
+	NameKanji    string          `json:"name_kanji"`
+	Animeography []AnimeResource `json:"animeography"`
+	Mangaography []AnimeResource `json:"mangaography"`
+	Favorites    int             `json:"favorites"`
+	ImageURL     string          `json:"image_url"`
+}
+
+// GetTopCharacters return top ranked characters per page, maximum 50 characters per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetTopCharacters(page int) (topCharacters TopCharacters, err error) {
+	return ths.GetTopCharactersContext(context.Background(), page)
+}
+
+func (ths *jikanClient) GetTopCharactersContext(ctx context.Context, page int) (topCharacters TopCharacters, err error) {
+	url := ths.topURL("characters", page, "")
+
+	err = ths.get(ctx, url, &topCharacters)
+	return
+}
@@ -0,0 +1,430 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTopEndpoints(t *testing.T) {
+	Convey("Testing Top Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+
+		Convey("Testing GetTopAnime Method", func() {
+			expectedTopAnime := TopAnime{
+				Top: []TopAnimeItem{
+					TopAnimeItem{
+						MalID:     5114,
+						Rank:      1,
+						Title:     "Fullmetal Alchemist: Brotherhood",
+						URL:       "https://myanimelist.net/anime/5114/Fullmetal_Alchemist__Brotherhood",
+						Type:      "TV",
+						Episodes:  64,
+						StartDate: "Apr 2009",
+						EndDate:   "Jul 2010",
+						Members:   2500000,
+						Score:     9.2,
+					},
+				},
+			}
+
+			expectedTopAnimeBytes, err := json.Marshal(expectedTopAnime)
+			So(err, ShouldBeNil)
+
+			Convey("GetTopAnime should return a TopAnime given valid page and subtype", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedTopAnimeBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/top/anime/2/airing")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topAnime, err := jikan.GetTopAnime(2, TopAnimeAiring)
+
+				So(topAnime, ShouldResemble, expectedTopAnime)
+				So(topAnime.Top[0].Rank, ShouldEqual, 1)
+				So(topAnime.Top[0].Score, ShouldEqual, 9.2)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetTopAnime should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				topAnime, err := jikan.GetTopAnime(2, TopAnimeAiring)
+
+				So(topAnime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetTopAnime should return ResourceNotFoundError given unknown page", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				topAnime, err := jikan.GetTopAnime(0, "")
+
+				So(topAnime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetTopAnime should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topAnime, err := jikan.GetTopAnime(0, "")
+
+				So(topAnime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetTopAnime should request the first page given subtype without page", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedTopAnimeBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/top/anime/1/bypopularity")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topAnime, err := jikan.GetTopAnime(0, TopAnimeByPopularity)
+
+				So(topAnime, ShouldResemble, expectedTopAnime)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetTopAnime should request top anime given no page and subtype", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedTopAnimeBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/top/anime")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topAnime, err := jikan.GetTopAnime(0, "")
+
+				So(topAnime, ShouldResemble, expectedTopAnime)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("Testing GetTopManga Method", func() {
+			expectedTopManga := TopManga{
+				Top: []TopMangaItem{
+					TopMangaItem{
+						MalID:     2,
+						Rank:      1,
+						Title:     "Berserk",
+						URL:       "https://myanimelist.net/manga/2/Berserk",
+						Type:      "Manga",
+						StartDate: "Aug 1989",
+						Members:   500000,
+						Score:     9.4,
+					},
+				},
+			}
+
+			expectedTopMangaBytes, err := json.Marshal(expectedTopManga)
+			So(err, ShouldBeNil)
+
+			Convey("GetTopManga should return a TopManga given valid page and subtype", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedTopMangaBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/top/manga/1/manhwa")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topManga, err := jikan.GetTopManga(1, TopMangaManhwa)
+
+				So(topManga, ShouldResemble, expectedTopManga)
+				So(topManga.Top[0].Rank, ShouldEqual, 1)
+				So(topManga.Top[0].Title, ShouldEqual, "Berserk")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetTopManga should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				topManga, err := jikan.GetTopManga(1, TopMangaManhwa)
+
+				So(topManga, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetTopManga should return ResourceNotFoundError given unknown page", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				topManga, err := jikan.GetTopManga(0, "")
+
+				So(topManga, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetTopManga should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topManga, err := jikan.GetTopManga(0, "")
+
+				So(topManga, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetTopPeople Method", func() {
+			expectedTopPeople := TopPeople{
+				Top: []TopPerson{
+					TopPerson{
+						MalID:     118,
+						Rank:      1,
+						Title:     "Hanazawa, Kana",
+						URL:       "https://myanimelist.net/people/118/Kana_Hanazawa",
+						NameKanji: "花澤 香菜",
+						Favorites: 80000,
+						Birthday:  time.Date(1989, time.February, 25, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			}
+
+			expectedTopPeopleBytes, err := json.Marshal(expectedTopPeople)
+			So(err, ShouldBeNil)
+
+			Convey("GetTopPeople should return a TopPeople given valid page", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedTopPeopleBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/top/people/2")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topPeople, err := jikan.GetTopPeople(2)
+
+				So(topPeople, ShouldResemble, expectedTopPeople)
+				So(topPeople.Top[0].Rank, ShouldEqual, 1)
+				So(topPeople.Top[0].Favorites, ShouldEqual, 80000)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetTopPeople should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				topPeople, err := jikan.GetTopPeople(2)
+
+				So(topPeople, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetTopPeople should return ResourceNotFoundError given unknown page", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				topPeople, err := jikan.GetTopPeople(0)
+
+				So(topPeople, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetTopPeople should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topPeople, err := jikan.GetTopPeople(0)
+
+				So(topPeople, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetTopCharacters Method", func() {
+			expectedTopCharacters := TopCharacters{
+				Top: []TopCharacter{
+					TopCharacter{
+						MalID:     417,
+						Rank:      1,
+						Title:     "Lamperouge, Lelouch",
+						URL:       "https://myanimelist.net/character/417/Lelouch_Lamperouge",
+						NameKanji: "ルルーシュ・ランペルージ",
+						Animeography: []AnimeResource{
+							AnimeResource{
+								MalID: 1575,
+								Type:  "anime",
+								Name:  "Code Geass: Hangyaku no Lelouch",
+								URL:   "https://myanimelist.net/anime/1575/Code_Geass__Hangyaku_no_Lelouch",
+							},
+						},
+						Favorites: 150000,
+					},
+				},
+			}
+
+			expectedTopCharactersBytes, err := json.Marshal(expectedTopCharacters)
+			So(err, ShouldBeNil)
+
+			Convey("GetTopCharacters should return a TopCharacters given valid page", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedTopCharactersBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/top/characters")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topCharacters, err := jikan.GetTopCharacters(0)
+
+				So(topCharacters, ShouldResemble, expectedTopCharacters)
+				So(topCharacters.Top[0].Rank, ShouldEqual, 1)
+				So(topCharacters.Top[0].Animeography[0].MalID, ShouldEqual, 1575)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetTopCharacters should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				topCharacters, err := jikan.GetTopCharacters(0)
+
+				So(topCharacters, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetTopCharacters should return ResourceNotFoundError given unknown page", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				topCharacters, err := jikan.GetTopCharacters(0)
+
+				So(topCharacters, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetTopCharacters should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				topCharacters, err := jikan.GetTopCharacters(0)
+
+				So(topCharacters, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,341 @@
+package gojikan
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+func (ths *jikanClient) userURL(username, request string) string {
+	return fmt.Sprintf("%s/user/%s/%s", ths.baseURL, url.PathEscape(username), request)
+}
+
+// UserAnimeStats is a struct of user's anime list statistics
+type UserAnimeStats struct {
+	DaysWatched     float64 `json:"days_watched"`
+	MeanScore       float64 `json:"mean_score"`
+	Watching        int     `json:"watching"`
+	Completed       int     `json:"completed"`
+	OnHold          int     `json:"on_hold"`
+	Dropped         int     `json:"dropped"`
+	PlanToWatch     int     `json:"plan_to_watch"`
+	TotalEntries    int     `json:"total_entries"`
+	Rewatched       int     `json:"rewatched"`
+	EpisodesWatched int     `json:"episodes_watched"`
+}
+
+// UserMangaStats is a struct of user's manga list statistics
+type UserMangaStats struct {
+	DaysRead     float64 `json:"days_read"`
+	MeanScore    float64 `json:"mean_score"`
+	Reading      int     `json:"reading"`
+	Completed    int     `json:"completed"`
+	OnHold       int     `json:"on_hold"`
+	Dropped      int     `json:"dropped"`
+	PlanToRead   int     `json:"plan_to_read"`
+	TotalEntries int     `json:"total_entries"`
+	Reread       int     `json:"reread"`
+	ChaptersRead int     `json:"chapters_read"`
+	VolumesRead  int     `json:"volumes_read"`
+}
+
+// UserFavorites is a struct of user's favorite anime, manga, characters and people
+type UserFavorites struct {
+	Anime      []PersonResource `json:"anime"`
+	Manga      []PersonResource `json:"manga"`
+	Characters []PersonResource `json:"characters"`
+	People     []PersonResource `json:"people"`
+}
+
+// UserProfile is a struct of user's profile details from MyAnimeList
+type UserProfile struct {
+	ResponseMeta
+
+	UserID     int            `json:"user_id"`
+	Username   string         `json:"username"`
+	URL        string         `json:"url"`
+	ImageURL   string         `json:"image_url"`
+	LastOnline time.Time      `json:"last_online"`
+	Gender     string         `json:"gender"`
+	Birthday   time.Time      `json:"birthday"`
+	Location   string         `json:"location"`
+	Joined     time.Time      `json:"joined"`
+	AnimeStats UserAnimeStats `json:"anime_stats"`
+	MangaStats UserMangaStats `json:"manga_stats"`
+	Favorites  UserFavorites  `json:"favorites"`
+	About      string         `json:"about"`
+}
+
+func (ths *jikanClient) GetUserProfile(username string) (userProfile UserProfile, err error) {
+	return ths.GetUserProfileContext(context.Background(), username)
+}
+
+func (ths *jikanClient) GetUserProfileContext(ctx context.Context, username string) (userProfile UserProfile, err error) {
+	url := ths.userURL(username, "profile")
+
+	err = ths.get(ctx, url, &userProfile)
+	return
+}
+
+// ===================================================================================================================================
+
+// HistoryKind is a type of user's history
+type HistoryKind string
+
+const (
+	// HistoryAnime is the user's history of watched anime episodes
+	HistoryAnime HistoryKind = "anime"
+
+	// HistoryManga is the user's history of read manga chapters
+	HistoryManga HistoryKind = "manga"
+)
+
+// UserHistory is a struct list of user's recent anime and manga progress
+type UserHistory struct {
+	ResponseMeta
+
+	History []UserHistoryEntry `json:"history"`
+}
+
+// UserHistoryEntry is a struct details of user's progress on an anime or manga
+type UserHistoryEntry struct {
+	Meta      AnimeResource `json:"meta"`
+	Increment int           `json:"increment"`
+	Date      time.Time     `json:"date"`
+}
+
+// GetUserHistory return user's recent anime or manga progress
+// Put empty string in kind parameter to get both anime and manga history
+func (ths *jikanClient) GetUserHistory(username string, kind HistoryKind) (userHistory UserHistory, err error) {
+	return ths.GetUserHistoryContext(context.Background(), username, kind)
+}
+
+func (ths *jikanClient) GetUserHistoryContext(ctx context.Context, username string, kind HistoryKind) (userHistory UserHistory, err error) {
+	url := ths.userURL(username, "history")
+	if kind != "" {
+		url = fmt.Sprintf("%s/%s", url, kind)
+	}
+
+	err = ths.get(ctx, url, &userHistory)
+	return
+}
+
+// ===================================================================================================================================
+
+// UserFriends is a struct list of user's friends, maximum 100 friends per page
+type UserFriends struct {
+	ResponseMeta
+
+	Friends []UserFriend `json:"friends"`
+}
+
+// UserFriend is a struct details of user's friend
+type UserFriend struct {
+	URL          string    `json:"url"`
+	Username     string    `json:"username"`
+	ImageURL     string    `json:"image_url"`
+	LastOnline   time.Time `json:"last_online"`
+	FriendsSince time.Time `json:"friends_since"`
+}
+
+// GetUserFriends return user's friends per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanClient) GetUserFriends(username string, page int) (userFriends UserFriends, err error) {
+	return ths.GetUserFriendsContext(context.Background(), username, page)
+}
+
+func (ths *jikanClient) GetUserFriendsContext(ctx context.Context, username string, page int) (userFriends UserFriends, err error) {
+	url := pagedURL(ths.userURL(username, "friends"), page)
+
+	err = ths.get(ctx, url, &userFriends)
+	return
+}
+
+// ===================================================================================================================================
+
+// ListStatus is a type of filter for user's anime and manga list
+type ListStatus string
+
+const (
+	// ListStatusAll lists every entry in the user's list
+	ListStatusAll ListStatus = "all"
+
+	// ListStatusWatching lists anime the user is watching
+	ListStatusWatching ListStatus = "watching"
+
+	// ListStatusReading lists manga the user is reading
+	ListStatusReading ListStatus = "reading"
+
+	// ListStatusCompleted lists completed entries
+	ListStatusCompleted ListStatus = "completed"
+
+	// ListStatusOnHold lists entries put on hold
+	ListStatusOnHold ListStatus = "onhold"
+
+	// ListStatusDropped lists dropped entries
+	ListStatusDropped ListStatus = "dropped"
+
+	// ListStatusPlanToWatch lists anime the user plans to watch
+	ListStatusPlanToWatch ListStatus = "plantowatch"
+
+	// ListStatusPlanToRead lists manga the user plans to read
+	ListStatusPlanToRead ListStatus = "plantoread"
+)
+
+// UserListOptions is a struct of search and sorting options for user's anime and manga list
+type UserListOptions struct {
+	// Search filters entries by title
+	Search string
+
+	// OrderBy and OrderBy2 are the primary and secondary sort fields, e.g. title, score, last_updated
+	OrderBy  string
+	OrderBy2 string
+
+	// Sort is the order direction, use SearchSortAscending or SearchSortDescending
+	Sort string
+}
+
+// Values return the query parameters of the options
+func (ths *UserListOptions) Values() url.Values {
+	values := url.Values{}
+	if ths == nil {
+		return values
+	}
+
+	params := map[string]string{
+		"search":    ths.Search,
+		"order_by":  ths.OrderBy,
+		"order_by2": ths.OrderBy2,
+		"sort":      ths.Sort,
+	}
+	for key, value := range params {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+
+	return values
+}
+
+func (ths *jikanClient) userListURL(username, listType string, filter ListStatus, page int, opts *UserListOptions) string {
+	url := ths.userURL(username, listType)
+
+	// page is only recognized after the filter segment
+	if filter == "" && page > 0 {
+		filter = ListStatusAll
+	}
+
+	if filter != "" {
+		url = fmt.Sprintf("%s/%s", url, filter)
+	}
+
+	url = pagedURL(url, page)
+	if params := opts.Values().Encode(); params != "" {
+		url = fmt.Sprintf("%s?%s", url, params)
+	}
+
+	return url
+}
+
+// UserAnimeList is a struct list of anime in user's list, maximum 300 anime per page
+type UserAnimeList struct {
+	ResponseMeta
+
+	Anime []UserAnimeListEntry `json:"anime"`
+}
+
+// UserAnimeListEntry is a struct details of an anime in user's list
+type UserAnimeListEntry struct {
+	MalID           int             `json:"mal_id"`
+	Title           string          `json:"title"`
+	VideoURL        string          `json:"video_url"`
+	URL             string          `json:"url"`
+	ImageURL        string          `json:"image_url"`
+	Type            string          `json:"type"`
+	WatchingStatus  int             `json:"watching_status"`
+	Score           int             `json:"score"`
+	WatchedEpisodes int             `json:"watched_episodes"`
+	TotalEpisodes   int             `json:"total_episodes"`
+	AiringStatus    int             `json:"airing_status"`
+	SeasonName      string          `json:"season_name"`
+	SeasonYear      int             `json:"season_year"`
+	HasEpisodeVideo bool            `json:"has_episode_video"`
+	HasPromoVideo   bool            `json:"has_promo_video"`
+	HasVideo        bool            `json:"has_video"`
+	IsRewatching    bool            `json:"is_rewatching"`
+	Tags            string          `json:"tags"`
+	Rating          string          `json:"rating"`
+	StartDate       time.Time       `json:"start_date"`
+	EndDate         time.Time       `json:"end_date"`
+	WatchStartDate  time.Time       `json:"watch_start_date"`
+	WatchEndDate    time.Time       `json:"watch_end_date"`
+	Days            int             `json:"days"`
+	Storage         string          `json:"storage"`
+	Priority        string          `json:"priority"`
+	AddedToList     bool            `json:"added_to_list"`
+	Studios         []AnimeResource `json:"studios"`
+	Licensors       []AnimeResource `json:"licensors"`
+}
+
+// GetUserAnimeList return anime in user's list per page
+// Put empty string in filter parameter, 0 in page parameter and nil in opts parameter if don't want to use them
+func (ths *jikanClient) GetUserAnimeList(username string, filter ListStatus, page int, opts *UserListOptions) (userAnimeList UserAnimeList, err error) {
+	return ths.GetUserAnimeListContext(context.Background(), username, filter, page, opts)
+}
+
+func (ths *jikanClient) GetUserAnimeListContext(ctx context.Context, username string, filter ListStatus, page int, opts *UserListOptions) (userAnimeList UserAnimeList, err error) {
+	url := ths.userListURL(username, "animelist", filter, page, opts)
+
+	err = ths.get(ctx, url, &userAnimeList)
+	return
+}
+
+// ===================================================================================================================================
+
+// UserMangaList is a struct list of manga in user's list, maximum 300 manga per page
+type UserMangaList struct {
+	ResponseMeta
+
+	Manga []UserMangaListEntry `json:"manga"`
+}
+
+// UserMangaListEntry is a struct details of a manga in user's list
+type UserMangaListEntry struct {
+	MalID            int             `json:"mal_id"`
+	Title            string          `json:"title"`
+	URL              string          `json:"url"`
+	ImageURL         string          `json:"image_url"`
+	Type             string          `json:"type"`
+	ReadingStatus    int             `json:"reading_status"`
+	Score            int             `json:"score"`
+	ReadChapters     int             `json:"read_chapters"`
+	ReadVolumes      int             `json:"read_volumes"`
+	TotalChapters    int             `json:"total_chapters"`
+	TotalVolumes     int             `json:"total_volumes"`
+	PublishingStatus int             `json:"publishing_status"`
+	IsRereading      bool            `json:"is_rereading"`
+	Tags             string          `json:"tags"`
+	StartDate        time.Time       `json:"start_date"`
+	EndDate          time.Time       `json:"end_date"`
+	ReadStartDate    time.Time       `json:"read_start_date"`
+	ReadEndDate      time.Time       `json:"read_end_date"`
+	Days             int             `json:"days"`
+	Retail           string          `json:"retail"`
+	Priority         string          `json:"priority"`
+	AddedToList      bool            `json:"added_to_list"`
+	Magazines        []AnimeResource `json:"magazines"`
+}
+
+// GetUserMangaList return manga in user's list per page
+// Put empty string in filter parameter, 0 in page parameter and nil in opts parameter if don't want to use them
+func (ths *jikanClient) GetUserMangaList(username string, filter ListStatus, page int, opts *UserListOptions) (userMangaList UserMangaList, err error) {
+	return ths.GetUserMangaListContext(context.Background(), username, filter, page, opts)
+}
+
+func (ths *jikanClient) GetUserMangaListContext(ctx context.Context, username string, filter ListStatus, page int, opts *UserListOptions) (userMangaList UserMangaList, err error) {
+	url := ths.userListURL(username, "mangalist", filter, page, opts)
+
+	err = ths.get(ctx, url, &userMangaList)
+	return
+}
@@ -0,0 +1,549 @@
+package gojikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUserEndpoints(t *testing.T) {
+	Convey("Testing User Endpoints Method", t, func() {
+		jikan := NewJikanClient().(*jikanClient)
+		username := "Nekomata1037"
+
+		Convey("Testing GetUserProfile Method", func() {
+			expectedUserProfile := UserProfile{
+				UserID:   1,
+				Username: username,
+				URL:      "https://myanimelist.net/profile/Nekomata1037",
+				Gender:   "Male",
+				Location: "Tokyo",
+				AnimeStats: UserAnimeStats{
+					DaysWatched:  100.5,
+					MeanScore:    7.8,
+					Completed:    300,
+					TotalEntries: 350,
+				},
+				MangaStats: UserMangaStats{
+					Reading:      10,
+					TotalEntries: 50,
+				},
+				Favorites: UserFavorites{
+					Anime: []PersonResource{
+						PersonResource{
+							MalID: 1,
+							URL:   "https://myanimelist.net/anime/1/Cowboy_Bebop",
+							Name:  "Cowboy Bebop",
+						},
+					},
+				},
+			}
+
+			expectedUserProfileBytes, err := json.Marshal(expectedUserProfile)
+			So(err, ShouldBeNil)
+
+			Convey("GetUserProfile should return an UserProfile given valid username", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserProfileBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/profile")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userProfile, err := jikan.GetUserProfile(username)
+
+				So(userProfile, ShouldResemble, expectedUserProfile)
+				So(userProfile.Username, ShouldEqual, username)
+				So(userProfile.AnimeStats.Completed, ShouldEqual, 300)
+				So(userProfile.Favorites.Anime[0].MalID, ShouldEqual, 1)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetUserProfile should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				userProfile, err := jikan.GetUserProfile(username)
+
+				So(userProfile, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetUserProfile should return ResourceNotFoundError given unknown username", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				userProfile, err := jikan.GetUserProfile("")
+
+				So(userProfile, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetUserProfile should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userProfile, err := jikan.GetUserProfile("")
+
+				So(userProfile, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetUserHistory Method", func() {
+			expectedUserHistory := UserHistory{
+				History: []UserHistoryEntry{
+					UserHistoryEntry{
+						Meta: AnimeResource{
+							MalID: 1,
+							Type:  "anime",
+							Name:  "Cowboy Bebop",
+							URL:   "https://myanimelist.net/anime/1/Cowboy_Bebop",
+						},
+						Increment: 5,
+					},
+				},
+			}
+
+			expectedUserHistoryBytes, err := json.Marshal(expectedUserHistory)
+			So(err, ShouldBeNil)
+
+			Convey("GetUserHistory should return an UserHistory given valid username", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserHistoryBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/history/anime")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userHistory, err := jikan.GetUserHistory(username, HistoryAnime)
+
+				So(userHistory, ShouldResemble, expectedUserHistory)
+				So(userHistory.History[0].Increment, ShouldEqual, 5)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetUserHistory should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				userHistory, err := jikan.GetUserHistory(username, HistoryAnime)
+
+				So(userHistory, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetUserHistory should return ResourceNotFoundError given unknown username", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				userHistory, err := jikan.GetUserHistory("", "")
+
+				So(userHistory, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetUserHistory should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userHistory, err := jikan.GetUserHistory("", "")
+
+				So(userHistory, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetUserHistory should request both anime and manga history given empty kind", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserHistoryBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/history")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userHistory, err := jikan.GetUserHistory(username, "")
+
+				So(userHistory, ShouldResemble, expectedUserHistory)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("Testing GetUserFriends Method", func() {
+			expectedUserFriends := UserFriends{
+				Friends: []UserFriend{
+					UserFriend{
+						URL:      "https://myanimelist.net/profile/Xinil",
+						Username: "Xinil",
+					},
+				},
+			}
+
+			expectedUserFriendsBytes, err := json.Marshal(expectedUserFriends)
+			So(err, ShouldBeNil)
+
+			Convey("GetUserFriends should return an UserFriends given valid username", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserFriendsBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/friends/2")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userFriends, err := jikan.GetUserFriends(username, 2)
+
+				So(userFriends, ShouldResemble, expectedUserFriends)
+				So(userFriends.Friends[0].Username, ShouldEqual, "Xinil")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetUserFriends should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				userFriends, err := jikan.GetUserFriends(username, 2)
+
+				So(userFriends, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetUserFriends should return ResourceNotFoundError given unknown username", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				userFriends, err := jikan.GetUserFriends("", 0)
+
+				So(userFriends, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetUserFriends should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userFriends, err := jikan.GetUserFriends("", 0)
+
+				So(userFriends, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetUserAnimeList Method", func() {
+			expectedUserAnimeList := UserAnimeList{
+				Anime: []UserAnimeListEntry{
+					UserAnimeListEntry{
+						MalID:           1,
+						Title:           "Cowboy Bebop",
+						URL:             "https://myanimelist.net/anime/1/Cowboy_Bebop",
+						Type:            "TV",
+						WatchingStatus:  1,
+						Score:           10,
+						WatchedEpisodes: 13,
+						TotalEpisodes:   26,
+						Studios: []AnimeResource{
+							AnimeResource{
+								MalID: 14,
+								Type:  "anime",
+								Name:  "Sunrise",
+								URL:   "https://myanimelist.net/anime/producer/14/Sunrise",
+							},
+						},
+					},
+				},
+			}
+
+			expectedUserAnimeListBytes, err := json.Marshal(expectedUserAnimeList)
+			So(err, ShouldBeNil)
+
+			Convey("GetUserAnimeList should return an UserAnimeList given valid username", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserAnimeListBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/animelist/watching/2")
+						So(req.URL.RawQuery, ShouldEqual, "search=bebop&sort=desc")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userAnimeList, err := jikan.GetUserAnimeList(username, ListStatusWatching, 2, &UserListOptions{Search: "bebop", Sort: SearchSortDescending})
+
+				So(userAnimeList, ShouldResemble, expectedUserAnimeList)
+				So(userAnimeList.Anime[0].WatchedEpisodes, ShouldEqual, 13)
+				So(userAnimeList.Anime[0].Studios[0].Name, ShouldEqual, "Sunrise")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetUserAnimeList should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				userAnimeList, err := jikan.GetUserAnimeList(username, ListStatusWatching, 2, &UserListOptions{Search: "bebop", Sort: SearchSortDescending})
+
+				So(userAnimeList, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetUserAnimeList should return ResourceNotFoundError given unknown username", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				userAnimeList, err := jikan.GetUserAnimeList("", "", 0, nil)
+
+				So(userAnimeList, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetUserAnimeList should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userAnimeList, err := jikan.GetUserAnimeList("", "", 0, nil)
+
+				So(userAnimeList, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetUserAnimeList should use all filter given page without filter", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserAnimeListBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/animelist/all/3")
+						So(req.URL.RawQuery, ShouldEqual, "")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userAnimeList, err := jikan.GetUserAnimeList(username, "", 3, nil)
+
+				So(userAnimeList, ShouldResemble, expectedUserAnimeList)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("Testing GetUserMangaList Method", func() {
+			expectedUserMangaList := UserMangaList{
+				Manga: []UserMangaListEntry{
+					UserMangaListEntry{
+						MalID:         1,
+						Title:         "Monster",
+						URL:           "https://myanimelist.net/manga/1/Monster",
+						Type:          "Manga",
+						ReadingStatus: 1,
+						ReadChapters:  100,
+						TotalChapters: 162,
+					},
+				},
+			}
+
+			expectedUserMangaListBytes, err := json.Marshal(expectedUserMangaList)
+			So(err, ShouldBeNil)
+
+			Convey("GetUserMangaList should return an UserMangaList given valid username", func() {
+				r := ioutil.NopCloser(bytes.NewReader(expectedUserMangaListBytes))
+
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						So(req.URL.Path, ShouldEndWith, "/user/Nekomata1037/mangalist/plantoread")
+						So(req.URL.RawQuery, ShouldEqual, "order_by=title&order_by2=score")
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userMangaList, err := jikan.GetUserMangaList(username, ListStatusPlanToRead, 0, &UserListOptions{OrderBy: "title", OrderBy2: "score"})
+
+				So(userMangaList, ShouldResemble, expectedUserMangaList)
+				So(userMangaList.Manga[0].ReadChapters, ShouldEqual, 100)
+				So(err, ShouldBeNil)
+			})
+
+			Convey("GetUserMangaList should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				userMangaList, err := jikan.GetUserMangaList(username, ListStatusPlanToRead, 0, &UserListOptions{OrderBy: "title", OrderBy2: "score"})
+
+				So(userMangaList, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetUserMangaList should return ResourceNotFoundError given unknown username", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				userMangaList, err := jikan.GetUserMangaList("", "", 0, nil)
+
+				So(userMangaList, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetUserMangaList should return error when unmarshaling unknown data type", func() {
+				r := ioutil.NopCloser(bytes.NewReader([]byte("Unknown Data")))
+
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 200,
+							Body:       r,
+						}, nil
+					},
+				}
+
+				userMangaList, err := jikan.GetUserMangaList("", "", 0, nil)
+
+				So(userMangaList, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestUserListOptions(t *testing.T) {
+	Convey("Testing UserListOptions Values Method", t, func() {
+		Convey("Values should encode every non empty option", func() {
+			opts := &UserListOptions{
+				Search:   "bebop",
+				OrderBy:  "title",
+				OrderBy2: "score",
+				Sort:     SearchSortAscending,
+			}
+
+			So(opts.Values().Encode(), ShouldEqual, "order_by=title&order_by2=score&search=bebop&sort=asc")
+		})
+
+		Convey("Values on nil or empty UserListOptions should return empty values", func() {
+			var opts *UserListOptions
+
+			So(opts.Values(), ShouldBeEmpty)
+			So((&UserListOptions{}).Values(), ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,636 @@
+package gojikan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type jikanV4Client struct {
+	*jikanClient
+	v4BaseURL string
+}
+
+// NewJikanV4Client will return a Client that speaks Jikan API v4 for the anime endpoints
+// and maps the responses into the same types returned by NewJikanClient
+// Endpoints that are not yet ported to v4 are still requested to Jikan API v3
+// WithBaseURL option replaces the v4 base URL and WithV3BaseURL replaces the v3 one,
+// without WithV3BaseURL the v3 base URL is derived from the v4 one so requests stay on the same host
+func NewJikanV4Client(opts ...Option) Client {
+	client := newJikanClient(jikanV4BaseURL, opts...)
+	v4BaseURL := client.baseURL
+	client.baseURL = v3BaseURL(v4BaseURL, client.v3BaseURL)
+
+	return &jikanV4Client{
+		jikanClient: client,
+		v4BaseURL:   v4BaseURL,
+	}
+}
+
+// v3BaseURL return the base URL of the endpoints not yet ported to v4, which is the configured one,
+// or the v4 base URL with its /v4 suffix replaced by /v3, or else the v4 base URL itself
+func v3BaseURL(v4BaseURL, configured string) string {
+	if configured != "" {
+		return configured
+	}
+
+	if strings.HasSuffix(v4BaseURL, "/v4") {
+		return strings.TrimSuffix(v4BaseURL, "/v4") + "/v3"
+	}
+
+	return v4BaseURL
+}
+
+type v4Pagination struct {
+	LastVisiblePage int  `json:"last_visible_page"`
+	HasNextPage     bool `json:"has_next_page"`
+}
+
+type v4Envelope struct {
+	Data       json.RawMessage `json:"data"`
+	Pagination v4Pagination    `json:"pagination"`
+}
+
+// getData requests the v4 url and unwraps the data of the response envelope into data
+func (ths *jikanV4Client) getData(ctx context.Context, url string, data interface{}) (pagination v4Pagination, err error) {
+	var envelope v4Envelope
+	err = ths.get(ctx, url, &envelope)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(envelope.Data, data)
+	if err != nil {
+		return
+	}
+
+	pagination = envelope.Pagination
+	return
+}
+
+func (ths *jikanV4Client) animeURL(id int, request string, page int) string {
+	url := fmt.Sprintf("%s/anime/%d", ths.v4BaseURL, id)
+	if request != "" {
+		url = fmt.Sprintf("%s/%s", url, request)
+	}
+
+	if page > 0 {
+		url = fmt.Sprintf("%s?page=%d", url, page)
+	}
+
+	return url
+}
+
+type v4Image struct {
+	ImageURL      string `json:"image_url"`
+	SmallImageURL string `json:"small_image_url"`
+	LargeImageURL string `json:"large_image_url"`
+}
+
+type v4Images struct {
+	JPG v4Image `json:"jpg"`
+}
+
+type v4Entry struct {
+	MalID  int      `json:"mal_id"`
+	URL    string   `json:"url"`
+	Images v4Images `json:"images"`
+	Name   string   `json:"name"`
+	Title  string   `json:"title"`
+}
+
+// ===================================================================================================================================
+
+type v4Anime struct {
+	MalID   int      `json:"mal_id"`
+	URL     string   `json:"url"`
+	Images  v4Images `json:"images"`
+	Trailer struct {
+		EmbedURL string `json:"embed_url"`
+	} `json:"trailer"`
+	Title         string        `json:"title"`
+	TitleEnglish  string        `json:"title_english"`
+	TitleJapanese string        `json:"title_japanese"`
+	TitleSynonyms []string      `json:"title_synonyms"`
+	Type          AnimeType     `json:"type"`
+	Source        AnimeSource   `json:"source"`
+	Episodes      int           `json:"episodes"`
+	Status        AnimeStatus   `json:"status"`
+	Airing        bool          `json:"airing"`
+	Aired         AiredTimeline `json:"aired"`
+	Duration      string        `json:"duration"`
+	Rating        Rating        `json:"rating"`
+	Score         float64       `json:"score"`
+	ScoredBy      int           `json:"scored_by"`
+	Rank          int           `json:"rank"`
+	Popularity    int           `json:"popularity"`
+	Members       int           `json:"members"`
+	Favorites     int           `json:"favorites"`
+	Synopsis      string        `json:"synopsis"`
+	Season        string        `json:"season"`
+	Year          int           `json:"year"`
+	Broadcast     struct {
+		String string `json:"string"`
+	} `json:"broadcast"`
+	Producers []AnimeResource `json:"producers"`
+	Licensors []AnimeResource `json:"licensors"`
+	Studios   []AnimeResource `json:"studios"`
+	Genres    []AnimeResource `json:"genres"`
+	Relations []struct {
+		Relation string          `json:"relation"`
+		Entry    []AnimeResource `json:"entry"`
+	} `json:"relations"`
+	Theme struct {
+		Openings []string `json:"openings"`
+		Endings  []string `json:"endings"`
+	} `json:"theme"`
+}
+
+func (ths *jikanV4Client) GetAnime(id int) (anime Anime, err error) {
+	return ths.GetAnimeContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeContext(ctx context.Context, id int) (anime Anime, err error) {
+	var data v4Anime
+	_, err = ths.getData(ctx, ths.animeURL(id, "full", 0), &data)
+	if err != nil {
+		return
+	}
+
+	anime = Anime{
+		MalID:         data.MalID,
+		URL:           data.URL,
+		ImageURL:      data.Images.JPG.ImageURL,
+		TrailerURL:    data.Trailer.EmbedURL,
+		Title:         data.Title,
+		TitleEnglish:  data.TitleEnglish,
+		TitleJapanese: data.TitleJapanese,
+		TitleSynonyms: data.TitleSynonyms,
+		Type:          data.Type,
+		Source:        data.Source,
+		Episodes:      data.Episodes,
+		Status:        data.Status,
+		Airing:        data.Airing,
+		Aired:         data.Aired,
+		Duration:      data.Duration,
+		Rating:        data.Rating,
+		Score:         data.Score,
+		ScoredBy:      data.ScoredBy,
+		Rank:          data.Rank,
+		Popularity:    data.Popularity,
+		Members:       data.Members,
+		Favorites:     data.Favorites,
+		Synopsis:      data.Synopsis,
+		Broadcast:     data.Broadcast.String,
+		Producers:     data.Producers,
+		Licensors:     data.Licensors,
+		Studios:       data.Studios,
+		Genres:        data.Genres,
+		OpeningThemes: data.Theme.Openings,
+		EndingThemes:  data.Theme.Endings,
+	}
+
+	if data.Season != "" && data.Year > 0 {
+		anime.Premiered = fmt.Sprintf("%s%s %d", strings.ToUpper(data.Season[:1]), data.Season[1:], data.Year)
+	}
+
+	for _, relation := range data.Relations {
+		anime.Related.Add(relation.Relation, relation.Entry...)
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeCharacter struct {
+	Character   v4Entry `json:"character"`
+	Role        string  `json:"role"`
+	VoiceActors []struct {
+		Person   v4Entry `json:"person"`
+		Language string  `json:"language"`
+	} `json:"voice_actors"`
+}
+
+type v4AnimeStaff struct {
+	Person    v4Entry  `json:"person"`
+	Positions []string `json:"positions"`
+}
+
+// GetAnimeCharacterStaff combines v4 characters and staff endpoints, so it sends two requests
+func (ths *jikanV4Client) GetAnimeCharacterStaff(id int) (animeCharStaff AnimeCharacterStaff, err error) {
+	return ths.GetAnimeCharacterStaffContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeCharacterStaffContext(ctx context.Context, id int) (animeCharStaff AnimeCharacterStaff, err error) {
+	var characters []v4AnimeCharacter
+	_, err = ths.getData(ctx, ths.animeURL(id, "characters", 0), &characters)
+	if err != nil {
+		return
+	}
+
+	var staff []v4AnimeStaff
+	_, err = ths.getData(ctx, ths.animeURL(id, "staff", 0), &staff)
+	if err != nil {
+		return
+	}
+
+	for _, character := range characters {
+		animeCharacter := AnimeCharacter{
+			MalID:    character.Character.MalID,
+			URL:      character.Character.URL,
+			ImageURL: character.Character.Images.JPG.ImageURL,
+			Name:     character.Character.Name,
+			Role:     character.Role,
+		}
+
+		for _, voiceActor := range character.VoiceActors {
+			animeCharacter.VoiceActors = append(animeCharacter.VoiceActors, AnimeVoiceActor{
+				MalID:    voiceActor.Person.MalID,
+				Name:     voiceActor.Person.Name,
+				URL:      voiceActor.Person.URL,
+				ImageURL: voiceActor.Person.Images.JPG.ImageURL,
+				Language: voiceActor.Language,
+			})
+		}
+
+		animeCharStaff.Characters = append(animeCharStaff.Characters, animeCharacter)
+	}
+
+	for _, member := range staff {
+		animeCharStaff.Staff = append(animeCharStaff.Staff, AnimeStaff{
+			MalID:     member.Person.MalID,
+			URL:       member.Person.URL,
+			Name:      member.Person.Name,
+			ImageURL:  member.Person.Images.JPG.ImageURL,
+			Positions: member.Positions,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeEpisode struct {
+	MalID         int       `json:"mal_id"`
+	URL           string    `json:"url"`
+	Title         string    `json:"title"`
+	TitleJapanese string    `json:"title_japanese"`
+	TitleRomanji  string    `json:"title_romanji"`
+	Aired         time.Time `json:"aired"`
+	Filler        bool      `json:"filler"`
+	Recap         bool      `json:"recap"`
+	ForumURL      string    `json:"forum_url"`
+}
+
+// GetAnimeAllEpisodes return all anime's episode per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanV4Client) GetAnimeAllEpisodes(id, page int) (animeEpisodes AnimeEpisodes, err error) {
+	return ths.GetAnimeAllEpisodesContext(context.Background(), id, page)
+}
+
+func (ths *jikanV4Client) GetAnimeAllEpisodesContext(ctx context.Context, id, page int) (animeEpisodes AnimeEpisodes, err error) {
+	var episodes []v4AnimeEpisode
+	pagination, err := ths.getData(ctx, ths.animeURL(id, "episodes", page), &episodes)
+	if err != nil {
+		return
+	}
+
+	animeEpisodes.EpisodesLastPage = pagination.LastVisiblePage
+	animeEpisodes.Episodes = []AnimeEpisode{}
+	for _, episode := range episodes {
+		animeEpisodes.Episodes = append(animeEpisodes.Episodes, AnimeEpisode{
+			EpisodeID:     episode.MalID,
+			Title:         episode.Title,
+			TitleJapanese: episode.TitleJapanese,
+			TitleRomanji:  episode.TitleRomanji,
+			Aired:         episode.Aired,
+			Filler:        episode.Filler,
+			Recap:         episode.Recap,
+			VideoURL:      episode.URL,
+			ForumURL:      episode.ForumURL,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeNews struct {
+	URL            string    `json:"url"`
+	Title          string    `json:"title"`
+	Date           time.Time `json:"date"`
+	AuthorUsername string    `json:"author_username"`
+	AuthorURL      string    `json:"author_url"`
+	ForumURL       string    `json:"forum_url"`
+	Images         v4Images  `json:"images"`
+	Comments       int       `json:"comments"`
+	Excerpt        string    `json:"excerpt"`
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedNews(id int) (animeNews AnimeNews, err error) {
+	return ths.GetAnimeRelatedNewsContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedNewsContext(ctx context.Context, id int) (animeNews AnimeNews, err error) {
+	var news []v4AnimeNews
+	_, err = ths.getData(ctx, ths.animeURL(id, "news", 0), &news)
+	if err != nil {
+		return
+	}
+
+	for _, article := range news {
+		animeNews.Articles = append(animeNews.Articles, AnimeNewsArticle{
+			URL:        article.URL,
+			Title:      article.Title,
+			Date:       article.Date,
+			AuthorName: article.AuthorUsername,
+			AuthorURL:  article.AuthorURL,
+			ForumURL:   article.ForumURL,
+			ImageURL:   article.Images.JPG.ImageURL,
+			Comments:   article.Comments,
+			Intro:      article.Excerpt,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+func (ths *jikanV4Client) GetAnimeRelatedPictures(id int) (animePictures AnimePictures, err error) {
+	return ths.GetAnimeRelatedPicturesContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedPicturesContext(ctx context.Context, id int) (animePictures AnimePictures, err error) {
+	var pictures []v4Images
+	_, err = ths.getData(ctx, ths.animeURL(id, "pictures", 0), &pictures)
+	if err != nil {
+		return
+	}
+
+	for _, picture := range pictures {
+		animePictures.Pictures = append(animePictures.Pictures, AnimePicture{
+			Large: picture.JPG.LargeImageURL,
+			Small: picture.JPG.ImageURL,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeVideos struct {
+	Promo []struct {
+		Title   string `json:"title"`
+		Trailer struct {
+			EmbedURL string  `json:"embed_url"`
+			Images   v4Image `json:"images"`
+		} `json:"trailer"`
+	} `json:"promo"`
+	Episodes []struct {
+		Title   string   `json:"title"`
+		Episode string   `json:"episode"`
+		URL     string   `json:"url"`
+		Images  v4Images `json:"images"`
+	} `json:"episodes"`
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedVideos(id int) (animeVideos AnimeVideos, err error) {
+	return ths.GetAnimeRelatedVideosContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedVideosContext(ctx context.Context, id int) (animeVideos AnimeVideos, err error) {
+	var videos v4AnimeVideos
+	_, err = ths.getData(ctx, ths.animeURL(id, "videos", 0), &videos)
+	if err != nil {
+		return
+	}
+
+	for _, promo := range videos.Promo {
+		animeVideos.Promo = append(animeVideos.Promo, AnimeVideoPromo{
+			Title:    promo.Title,
+			ImageURL: promo.Trailer.Images.ImageURL,
+			VideoURL: promo.Trailer.EmbedURL,
+		})
+	}
+
+	for _, episode := range videos.Episodes {
+		animeVideos.Episodes = append(animeVideos.Episodes, AnimeVideoEpisode{
+			Title:    episode.Title,
+			Episode:  episode.Episode,
+			URL:      episode.URL,
+			ImageURL: episode.Images.JPG.ImageURL,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeStats struct {
+	Watching    int `json:"watching"`
+	Completed   int `json:"completed"`
+	OnHold      int `json:"on_hold"`
+	Dropped     int `json:"dropped"`
+	PlanToWatch int `json:"plan_to_watch"`
+	Total       int `json:"total"`
+	Scores      []struct {
+		Score      int     `json:"score"`
+		Votes      int     `json:"votes"`
+		Percentage float64 `json:"percentage"`
+	} `json:"scores"`
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedStats(id int) (animeStats AnimeStats, err error) {
+	return ths.GetAnimeRelatedStatsContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedStatsContext(ctx context.Context, id int) (animeStats AnimeStats, err error) {
+	var stats v4AnimeStats
+	_, err = ths.getData(ctx, ths.animeURL(id, "statistics", 0), &stats)
+	if err != nil {
+		return
+	}
+
+	animeStats = AnimeStats{
+		Watching:    stats.Watching,
+		Completed:   stats.Completed,
+		OnHold:      stats.OnHold,
+		Dropped:     stats.Dropped,
+		PlanToWatch: stats.PlanToWatch,
+		Total:       stats.Total,
+	}
+
+	scores := []*AnimeScoreValue{
+		&animeStats.Scores.One, &animeStats.Scores.Two, &animeStats.Scores.Three, &animeStats.Scores.Four, &animeStats.Scores.Five,
+		&animeStats.Scores.Six, &animeStats.Scores.Seven, &animeStats.Scores.Eight, &animeStats.Scores.Nine, &animeStats.Scores.Ten,
+	}
+	for _, score := range stats.Scores {
+		if score.Score >= 1 && score.Score <= 10 {
+			*scores[score.Score-1] = AnimeScoreValue{Votes: score.Votes, Percentage: score.Percentage}
+		}
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeForumTopic struct {
+	MalID          int       `json:"mal_id"`
+	URL            string    `json:"url"`
+	Title          string    `json:"title"`
+	Date           time.Time `json:"date"`
+	AuthorUsername string    `json:"author_username"`
+	AuthorURL      string    `json:"author_url"`
+	Comments       int       `json:"comments"`
+	LastComment    struct {
+		URL            string    `json:"url"`
+		AuthorUsername string    `json:"author_username"`
+		AuthorURL      string    `json:"author_url"`
+		Date           time.Time `json:"date"`
+	} `json:"last_comment"`
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedForum(id int) (animeForum AnimeForum, err error) {
+	return ths.GetAnimeRelatedForumContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeRelatedForumContext(ctx context.Context, id int) (animeForum AnimeForum, err error) {
+	var topics []v4AnimeForumTopic
+	_, err = ths.getData(ctx, ths.animeURL(id, "forum", 0), &topics)
+	if err != nil {
+		return
+	}
+
+	for _, topic := range topics {
+		animeForum.Topics = append(animeForum.Topics, AnimeForumTopic{
+			TopicID:    topic.MalID,
+			URL:        topic.URL,
+			Title:      topic.Title,
+			DatePosted: topic.Date,
+			AuthorName: topic.AuthorUsername,
+			AuthorURL:  topic.AuthorURL,
+			Replies:    topic.Comments,
+			LastPost: AnimeForumTopicLastPost{
+				URL:        topic.LastComment.URL,
+				AuthorName: topic.LastComment.AuthorUsername,
+				AuthorURL:  topic.LastComment.AuthorURL,
+				DatePosted: topic.LastComment.Date,
+			},
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeRecommendation struct {
+	Entry v4Entry `json:"entry"`
+	URL   string  `json:"url"`
+	Votes int     `json:"votes"`
+}
+
+func (ths *jikanV4Client) GetAnimeRecommendations(id int) (animeRecommendations AnimeRecommendations, err error) {
+	return ths.GetAnimeRecommendationsContext(context.Background(), id)
+}
+
+func (ths *jikanV4Client) GetAnimeRecommendationsContext(ctx context.Context, id int) (animeRecommendations AnimeRecommendations, err error) {
+	var recommendations []v4AnimeRecommendation
+	_, err = ths.getData(ctx, ths.animeURL(id, "recommendations", 0), &recommendations)
+	if err != nil {
+		return
+	}
+
+	for _, recommendation := range recommendations {
+		animeRecommendations.Recommendations = append(animeRecommendations.Recommendations, AnimeRecommendation{
+			MalID:               recommendation.Entry.MalID,
+			URL:                 recommendation.Entry.URL,
+			ImageURL:            recommendation.Entry.Images.JPG.ImageURL,
+			RecommendationURL:   recommendation.URL,
+			Title:               recommendation.Entry.Title,
+			RecommendationCount: recommendation.Votes,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+type v4AnimeReview struct {
+	MalID     int    `json:"mal_id"`
+	URL       string `json:"url"`
+	Type      string `json:"type"`
+	Reactions struct {
+		Overall int `json:"overall"`
+	} `json:"reactions"`
+	Date            time.Time `json:"date"`
+	Review          string    `json:"review"`
+	Score           int       `json:"score"`
+	EpisodesWatched int       `json:"episodes_watched"`
+	User            struct {
+		URL      string   `json:"url"`
+		Username string   `json:"username"`
+		Images   v4Images `json:"images"`
+	} `json:"user"`
+}
+
+// GetAnimeReviews return anime's reviews per page
+// Put 0 in page parameter if don't want to use the page
+func (ths *jikanV4Client) GetAnimeReviews(id, page int) (animeReviews AnimeReviews, err error) {
+	return ths.GetAnimeReviewsContext(context.Background(), id, page)
+}
+
+func (ths *jikanV4Client) GetAnimeReviewsContext(ctx context.Context, id, page int) (animeReviews AnimeReviews, err error) {
+	var reviews []v4AnimeReview
+	_, err = ths.getData(ctx, ths.animeURL(id, "reviews", page), &reviews)
+	if err != nil {
+		return
+	}
+
+	for _, review := range reviews {
+		animeReviews.Reviews = append(animeReviews.Reviews, AnimeReview{
+			MalID:        review.MalID,
+			URL:          review.URL,
+			Type:         review.Type,
+			HelpfulCount: review.Reactions.Overall,
+			Date:         review.Date,
+			Reviewer: AnimeReviewer{
+				URL:          review.User.URL,
+				ImageURL:     review.User.Images.JPG.ImageURL,
+				Username:     review.User.Username,
+				EpisodesSeen: review.EpisodesWatched,
+				Scores:       AnimeReviewScore{Overall: review.Score},
+			},
+			Content: review.Review,
+		})
+	}
+
+	return
+}
+
+// ===================================================================================================================================
+
+// GetAnimeBatch return the anime of every id from Jikan API v4 in the same order as ids
+func (ths *jikanV4Client) GetAnimeBatch(ctx context.Context, ids []int, opts *BatchOptions) (results []AnimeBatchResult, err error) {
+	return getAnimeBatch(ctx, ths, ids, opts)
+}
+
+// GetAnimeFull return the anime with the included parts from Jikan API v4
+func (ths *jikanV4Client) GetAnimeFull(ctx context.Context, id int, include ...Part) (animeFull AnimeFull, err error) {
+	return getAnimeFull(ctx, ths, id, include...)
+}
+
+// BuildFranchise return the franchise of the anime by walking its relations from Jikan API v4
+func (ths *jikanV4Client) BuildFranchise(ctx context.Context, rootID int, opts *FranchiseOptions) (franchise Franchise, err error) {
+	return buildFranchise(ctx, ths, rootID, opts)
+}
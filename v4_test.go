@@ -0,0 +1,316 @@
+package gojikan
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestJikanV4Endpoints(t *testing.T) {
+	Convey("Testing Jikan V4 Endpoints Method", t, func() {
+		jikan := NewJikanV4Client().(*jikanV4Client)
+		animeID := 1
+
+		mockResponse := func(url, body string) {
+			jikan.client = &MockClient{
+				MockDo: func(req *http.Request) (*http.Response, error) {
+					So(req.URL.String(), ShouldEqual, url)
+
+					return &http.Response{
+						StatusCode: 200,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+					}, nil
+				},
+			}
+		}
+
+		Convey("Testing GetAnime Method", func() {
+			Convey("GetAnime should map v4 full anime into an Anime given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/full", `{"data":{
+					"mal_id":1,
+					"url":"https://myanimelist.net/anime/1/Cowboy_Bebop",
+					"images":{"jpg":{"image_url":"https://cdn.myanimelist.net/images/anime/4/19644.jpg"}},
+					"trailer":{"embed_url":"https://www.youtube.com/embed/qig4KOK2R2g"},
+					"title":"Cowboy Bebop",
+					"type":"TV",
+					"episodes":26,
+					"aired":{"from":"1998-04-03T00:00:00+00:00","prop":{"from":{"day":3,"month":4,"year":1998}},"string":"Apr 3, 1998 to Apr 24, 1999"},
+					"score":8.75,
+					"season":"spring",
+					"year":1998,
+					"broadcast":{"day":"Saturdays","time":"01:00","timezone":"Asia/Tokyo","string":"Saturdays at 01:00 (JST)"},
+					"studios":[{"mal_id":14,"type":"anime","name":"Sunrise","url":"https://myanimelist.net/anime/producer/14/Sunrise"}],
+					"relations":[
+						{"relation":"Adaptation","entry":[{"mal_id":173,"type":"manga","name":"Cowboy Bebop","url":"https://myanimelist.net/manga/173/Cowboy_Bebop"}]},
+						{"relation":"Side Story","entry":[{"mal_id":5,"type":"anime","name":"Cowboy Bebop: Tengoku no Tobira","url":"https://myanimelist.net/anime/5"}]},
+						{"relation":"Spin-Off","entry":[{"mal_id":17205,"type":"anime","name":"Cowboy Bebop: Ein no Natsuyasumi","url":"https://myanimelist.net/anime/17205"}]}
+					],
+					"theme":{"openings":["Tank! by The Seatbelts"],"endings":["The Real Folk Blues by The Seatbelts"]}
+				}}`)
+
+				anime, err := jikan.GetAnime(animeID)
+
+				So(err, ShouldBeNil)
+				So(anime.MalID, ShouldEqual, animeID)
+				So(anime.ImageURL, ShouldEqual, "https://cdn.myanimelist.net/images/anime/4/19644.jpg")
+				So(anime.TrailerURL, ShouldEqual, "https://www.youtube.com/embed/qig4KOK2R2g")
+				So(anime.Title, ShouldEqual, "Cowboy Bebop")
+				So(anime.Episodes, ShouldEqual, 26)
+				So(anime.Aired.From.Equal(time.Date(1998, time.April, 3, 0, 0, 0, 0, time.UTC)), ShouldBeTrue)
+				So(anime.Aired.Prop.From.Year, ShouldEqual, 1998)
+				So(anime.Premiered, ShouldEqual, "Spring 1998")
+				So(anime.Broadcast, ShouldEqual, "Saturdays at 01:00 (JST)")
+				So(anime.Studios[0].Name, ShouldEqual, "Sunrise")
+				So(anime.Related.Adaptation[0].MalID, ShouldEqual, 173)
+				So(anime.Related.SideStory[0].MalID, ShouldEqual, 5)
+				So(anime.Related.SpinOff[0].MalID, ShouldEqual, 17205)
+				So(anime.OpeningThemes, ShouldResemble, []string{"Tank! by The Seatbelts"})
+				So(anime.EndingThemes, ShouldResemble, []string{"The Real Folk Blues by The Seatbelts"})
+			})
+
+			Convey("GetAnime should return error when the API call failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return nil, errors.New("Something happened when requesting")
+					},
+				}
+
+				anime, err := jikan.GetAnime(animeID)
+
+				So(anime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "Something happened when requesting")
+			})
+
+			Convey("GetAnime should return ResourceNotFoundError given unknown ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(*http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: 404,
+							Body:       nil,
+						}, nil
+					},
+				}
+
+				anime, err := jikan.GetAnime(0)
+
+				So(anime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, ResourceNotFoundError)
+			})
+
+			Convey("GetAnime should return error when unmarshaling unknown data type", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/0/full", "Unknown Data")
+
+				anime, err := jikan.GetAnime(0)
+
+				So(anime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("GetAnime should return error when the envelope data has unknown data type", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/0/full", `{"data":"Unknown Data"}`)
+
+				anime, err := jikan.GetAnime(0)
+
+				So(anime, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Testing GetAnimeCharacterStaff Method", func() {
+			Convey("GetAnimeCharacterStaff should combine v4 characters and staff given valid ID", func() {
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						body := `{"data":[{"person":{"mal_id":6519,"url":"https://myanimelist.net/people/6519","images":{"jpg":{"image_url":"staff.jpg"}},"name":"Watanabe, Shinichiro"},"positions":["Director"]}]}`
+						if req.URL.Path == "/v4/anime/1/characters" {
+							body = `{"data":[{"character":{"mal_id":1,"url":"https://myanimelist.net/character/1","images":{"jpg":{"image_url":"spike.jpg"}},"name":"Spiegel, Spike"},"role":"Main","voice_actors":[{"person":{"mal_id":11,"name":"Yamadera, Kouichi"},"language":"Japanese"}]}]}`
+						}
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+						}, nil
+					},
+				}
+
+				animeCharStaff, err := jikan.GetAnimeCharacterStaff(animeID)
+
+				So(err, ShouldBeNil)
+				So(animeCharStaff.Characters[0].Name, ShouldEqual, "Spiegel, Spike")
+				So(animeCharStaff.Characters[0].ImageURL, ShouldEqual, "spike.jpg")
+				So(animeCharStaff.Characters[0].Role, ShouldEqual, "Main")
+				So(animeCharStaff.Characters[0].VoiceActors[0].MalID, ShouldEqual, 11)
+				So(animeCharStaff.Characters[0].VoiceActors[0].Language, ShouldEqual, "Japanese")
+				So(animeCharStaff.Staff[0].Name, ShouldEqual, "Watanabe, Shinichiro")
+				So(animeCharStaff.Staff[0].Positions, ShouldResemble, []string{"Director"})
+			})
+
+			Convey("GetAnimeCharacterStaff should return error when the staff request failed", func() {
+				jikan.client = &MockClient{
+					MockDo: func(req *http.Request) (*http.Response, error) {
+						if req.URL.Path == "/v4/anime/1/staff" {
+							return &http.Response{StatusCode: 429}, nil
+						}
+
+						return &http.Response{
+							StatusCode: 200,
+							Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"data":[]}`))),
+						}, nil
+					},
+				}
+
+				animeCharStaff, err := jikan.GetAnimeCharacterStaff(animeID)
+
+				So(animeCharStaff, ShouldBeZeroValue)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, RateLimitedError)
+			})
+		})
+
+		Convey("Testing GetAnimeAllEpisodes Method", func() {
+			Convey("GetAnimeAllEpisodes should map v4 episodes and pagination given valid ID and page", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/episodes?page=2", `{
+					"pagination":{"last_visible_page":2,"has_next_page":false},
+					"data":[{"mal_id":26,"url":"https://myanimelist.net/anime/1/Cowboy_Bebop/episode/26","title":"The Real Folk Blues (Part 2)","filler":false,"recap":false,"forum_url":"https://myanimelist.net/forum/?topicid=1"}]
+				}`)
+
+				animeEpisodes, err := jikan.GetAnimeAllEpisodes(animeID, 2)
+
+				So(err, ShouldBeNil)
+				So(animeEpisodes.EpisodesLastPage, ShouldEqual, 2)
+				So(animeEpisodes.Episodes[0].EpisodeID, ShouldEqual, 26)
+				So(animeEpisodes.Episodes[0].VideoURL, ShouldEqual, "https://myanimelist.net/anime/1/Cowboy_Bebop/episode/26")
+				So(animeEpisodes.Episodes[0].ForumURL, ShouldEqual, "https://myanimelist.net/forum/?topicid=1")
+			})
+
+			Convey("GetAnimeAllEpisodes should return empty episodes given page without episodes", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/episodes", `{"pagination":{"last_visible_page":1},"data":[]}`)
+
+				animeEpisodes, err := jikan.GetAnimeAllEpisodes(animeID, 0)
+
+				So(err, ShouldBeNil)
+				So(animeEpisodes.EpisodesLastPage, ShouldEqual, 1)
+				So(animeEpisodes.Episodes, ShouldBeEmpty)
+			})
+		})
+
+		Convey("Testing GetAnimeRelatedNews Method", func() {
+			Convey("GetAnimeRelatedNews should map v4 news given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/news", `{"data":[{"url":"https://myanimelist.net/news/1","title":"News","author_username":"Snow","images":{"jpg":{"image_url":"news.jpg"}},"comments":3,"excerpt":"Intro"}]}`)
+
+				animeNews, err := jikan.GetAnimeRelatedNews(animeID)
+
+				So(err, ShouldBeNil)
+				So(animeNews.Articles[0].AuthorName, ShouldEqual, "Snow")
+				So(animeNews.Articles[0].ImageURL, ShouldEqual, "news.jpg")
+				So(animeNews.Articles[0].Comments, ShouldEqual, 3)
+				So(animeNews.Articles[0].Intro, ShouldEqual, "Intro")
+			})
+		})
+
+		Convey("Testing GetAnimeRelatedPictures Method", func() {
+			Convey("GetAnimeRelatedPictures should map v4 pictures given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/pictures", `{"data":[{"jpg":{"image_url":"small.jpg","large_image_url":"large.jpg"}}]}`)
+
+				animePictures, err := jikan.GetAnimeRelatedPictures(animeID)
+
+				So(err, ShouldBeNil)
+				So(animePictures.Pictures, ShouldResemble, []AnimePicture{AnimePicture{Large: "large.jpg", Small: "small.jpg"}})
+			})
+		})
+
+		Convey("Testing GetAnimeRelatedVideos Method", func() {
+			Convey("GetAnimeRelatedVideos should map v4 videos given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/videos", `{"data":{
+					"promo":[{"title":"PV 1","trailer":{"embed_url":"https://www.youtube.com/embed/1","images":{"image_url":"promo.jpg"}}}],
+					"episodes":[{"title":"Asteroid Blues","episode":"Episode 1","url":"https://myanimelist.net/anime/1/episode/1","images":{"jpg":{"image_url":"episode.jpg"}}}]
+				}}`)
+
+				animeVideos, err := jikan.GetAnimeRelatedVideos(animeID)
+
+				So(err, ShouldBeNil)
+				So(animeVideos.Promo[0], ShouldResemble, AnimeVideoPromo{Title: "PV 1", ImageURL: "promo.jpg", VideoURL: "https://www.youtube.com/embed/1"})
+				So(animeVideos.Episodes[0].Episode, ShouldEqual, "Episode 1")
+				So(animeVideos.Episodes[0].ImageURL, ShouldEqual, "episode.jpg")
+			})
+		})
+
+		Convey("Testing GetAnimeRelatedStats Method", func() {
+			Convey("GetAnimeRelatedStats should map v4 statistics given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/statistics", `{"data":{"watching":10,"completed":20,"total":30,"scores":[{"score":1,"votes":5,"percentage":0.5},{"score":10,"votes":100,"percentage":50.5},{"score":11,"votes":1}]}}`)
+
+				animeStats, err := jikan.GetAnimeRelatedStats(animeID)
+
+				So(err, ShouldBeNil)
+				So(animeStats.Watching, ShouldEqual, 10)
+				So(animeStats.Total, ShouldEqual, 30)
+				So(animeStats.Scores.One, ShouldResemble, AnimeScoreValue{Votes: 5, Percentage: 0.5})
+				So(animeStats.Scores.Ten, ShouldResemble, AnimeScoreValue{Votes: 100, Percentage: 50.5})
+				So(animeStats.Scores.Five, ShouldBeZeroValue)
+			})
+		})
+
+		Convey("Testing GetAnimeRelatedForum Method", func() {
+			Convey("GetAnimeRelatedForum should map v4 forum topics given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/forum", `{"data":[{"mal_id":2,"title":"Episode 1 Discussion","author_username":"Snow","comments":42,"last_comment":{"author_username":"Xinil"}}]}`)
+
+				animeForum, err := jikan.GetAnimeRelatedForum(animeID)
+
+				So(err, ShouldBeNil)
+				So(animeForum.Topics[0].TopicID, ShouldEqual, 2)
+				So(animeForum.Topics[0].AuthorName, ShouldEqual, "Snow")
+				So(animeForum.Topics[0].Replies, ShouldEqual, 42)
+				So(animeForum.Topics[0].LastPost.AuthorName, ShouldEqual, "Xinil")
+			})
+		})
+
+		Convey("Testing GetAnimeRecommendations Method", func() {
+			Convey("GetAnimeRecommendations should map v4 recommendations given valid ID", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/recommendations", `{"data":[{"entry":{"mal_id":205,"url":"https://myanimelist.net/anime/205","images":{"jpg":{"image_url":"champloo.jpg"}},"title":"Samurai Champloo"},"url":"https://myanimelist.net/recommendations/anime/1-205","votes":99}]}`)
+
+				animeRecommendations, err := jikan.GetAnimeRecommendations(animeID)
+
+				So(err, ShouldBeNil)
+				So(animeRecommendations.Recommendations[0], ShouldResemble, AnimeRecommendation{
+					MalID:               205,
+					URL:                 "https://myanimelist.net/anime/205",
+					ImageURL:            "champloo.jpg",
+					RecommendationURL:   "https://myanimelist.net/recommendations/anime/1-205",
+					Title:               "Samurai Champloo",
+					RecommendationCount: 99,
+				})
+			})
+		})
+
+		Convey("Testing GetAnimeReviews Method", func() {
+			Convey("GetAnimeReviews should map v4 reviews given valid ID and page", func() {
+				mockResponse("https://api.jikan.moe/v4/anime/1/reviews?page=2", `{"data":[{"mal_id":7406,"type":"anime","reactions":{"overall":120},"review":"This is a first review","score":9,"episodes_watched":26,"user":{"username":"Snow","images":{"jpg":{"image_url":"snow.jpg"}}}}]}`)
+
+				animeReviews, err := jikan.GetAnimeReviews(animeID, 2)
+
+				So(err, ShouldBeNil)
+				So(animeReviews.Reviews[0].MalID, ShouldEqual, 7406)
+				So(animeReviews.Reviews[0].HelpfulCount, ShouldEqual, 120)
+				So(animeReviews.Reviews[0].Content, ShouldEqual, "This is a first review")
+				So(animeReviews.Reviews[0].Reviewer.Username, ShouldEqual, "Snow")
+				So(animeReviews.Reviews[0].Reviewer.EpisodesSeen, ShouldEqual, 26)
+				So(animeReviews.Reviews[0].Reviewer.Scores.Overall, ShouldEqual, 9)
+			})
+		})
+
+		Convey("Endpoints not ported to v4 should still request Jikan API v3", func() {
+			mockResponse("https://api.jikan.moe/v3/manga/1", `{"mal_id":1,"title":"Monster"}`)
+
+			manga, err := jikan.GetManga(1)
+
+			So(err, ShouldBeNil)
+			So(manga.Title, ShouldEqual, "Monster")
+		})
+	})
+}